@@ -0,0 +1,226 @@
+package napi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// Split AbortSignal of last argument from arguments of Go function with params parameters, last argument is
+// AbortSignal or object with signal option, options only after arguments of Go function, objects decoded to
+// Go parameters are kept even with signal field.
+func splitAbortSignal(env EnvType, args []ValueType, params int, variadic bool) ([]ValueType, *Object, error) {
+	if len(args) == 0 {
+		return args, nil, nil
+	} else if typeOf, err := args[len(args)-1].Type(); err != nil || typeOf != TypeObject {
+		return args, nil, err
+	}
+
+	global, err := env.Global()
+	if err != nil {
+		return nil, nil, err
+	}
+	abortSignal, err := global.Get("AbortSignal")
+	if err != nil {
+		return nil, nil, err
+	} else if typeOf, _ := abortSignal.Type(); typeOf != TypeFunction {
+		return args, nil, nil // Runtime without AbortSignal
+	}
+
+	last := ToObject(args[len(args)-1])
+	if ok, err := last.InstanceOf(abortSignal); err != nil {
+		return nil, nil, err
+	} else if ok {
+		return args[:len(args)-1], last, nil
+	} else if variadic || len(args) <= params {
+		return args, nil, nil // Argument of Go function
+	}
+	option, err := last.Get("signal")
+	if err != nil {
+		return nil, nil, err
+	} else if typeOf, _ := option.Type(); typeOf != TypeObject {
+		return args, nil, nil
+	} else if ok, err := ToObject(option).InstanceOf(abortSignal); err != nil || !ok {
+		return args, nil, err
+	}
+	return args[:len(args)-1], ToObject(option), nil
+}
+
+// Create AbortError same of Node.js, with reason of signal as cause
+func abortError(env EnvType, reason ValueType) (*Exception, error) {
+	jsErr, err := CreateError(env, "The operation was aborted")
+	if err != nil {
+		return nil, err
+	}
+	obj := ToObject(jsErr)
+	for key, value := range map[string]string{"name": "AbortError", "code": "ABORT_ERR"} {
+		str, err := CreateString(env, value)
+		if err != nil {
+			return nil, err
+		} else if err = obj.Set(key, str); err != nil {
+			return nil, err
+		}
+	}
+	if reason != nil {
+		if typeOf, _ := reason.Type(); typeOf != TypeUndefined {
+			if err = obj.Set("cause", reason); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return exceptionOf(jsErr), nil
+}
+
+// Call Go function with context.Context as first argument in goroutine and return promise,
+// context is cancelled and promise rejected with AbortError if AbortSignal of arguments is aborted.
+// Goroutine is from executor, see [Executor].
+func callContextFunc(ci *CallbackInfo, ptr reflect.Value, executor *Executor) (ValueType, error) {
+	fnType := ptr.Type()
+	return callContext(ci, executor, fnType.NumIn()-1, fnType.IsVariadic(), func(args []ValueType) (func(ctx context.Context) (Marshaler, error), error) {
+		goArgs, err := goValuesInFunc(ptr, args, fnType.IsVariadic(), 1)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) (Marshaler, error) {
+			in := append([]reflect.Value{reflect.ValueOf(ctx)}, goArgs...)
+			if fnType.IsVariadic() {
+				return funcResults(ptr.CallSlice(in)), nil
+			}
+			return funcResults(ptr.Call(in)), nil
+		}, nil
+	})
+}
+
+// CallContext call Go function with context.Context same of [GoFuncOf] without reflection, used by code generated with napi-go bind.
+//
+// params is count of parameters after context.Context, to find AbortSignal in last argument.
+// decode is called in Javascript thread with arguments without AbortSignal and return function called in goroutine,
+// result of function resolve promise and is converted with [ValueOf] in Javascript thread.
+func CallContext(ci *CallbackInfo, params int, variadic bool, decode func(args []ValueType) (func(ctx context.Context) (Marshaler, error), error)) (ValueType, error) {
+	return callContext(ci, nil, params, variadic, decode)
+}
+
+func callContext(ci *CallbackInfo, executor *Executor, params int, variadic bool, decode func(args []ValueType) (func(ctx context.Context) (Marshaler, error), error)) (ValueType, error) {
+	args, signal, err := splitAbortSignal(ci.Env, ci.Args, params, variadic)
+	if err != nil {
+		return nil, err
+	}
+
+	call, err := decode(args)
+	if err != nil {
+		return nil, err
+	}
+	deferred, err := NewDeferred[Marshaler](ci.Env)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if signal != nil {
+		if err = listenAbort(ci.Env, signal, deferred, cancel); err != nil {
+			cancel()
+			deferred.Reject(err)
+			return deferred.Promise(), nil
+		} else if ctx.Err() != nil { // Already aborted
+			return deferred.Promise(), nil
+		}
+	}
+
+	err = runAsync(ci.Env, executor, func() {
+		defer cancel()
+		defer func() {
+			if v := recover(); v != nil {
+				deferred.Reject(fmt.Errorf("panic recover: %v", v))
+			}
+		}()
+		if ctx.Err() != nil {
+			return // Aborted in queue, promise already rejected
+		} else if result, err := call(ctx); err != nil {
+			deferred.Reject(err)
+		} else {
+			deferred.Resolve(result)
+		}
+	})
+	if err != nil {
+		cancel()
+		deferred.Reject(err)
+	}
+	return deferred.Promise(), nil
+}
+
+// Cancel ctx and reject deferred with AbortError on abort event of signal,
+// listener is removed when deferred is settled.
+func listenAbort(env EnvType, signal *Object, deferred *Deferred[Marshaler], cancel context.CancelFunc) error {
+	abort := func(env EnvType, signal *Object) error {
+		defer cancel() // Cancel after reject, else Go function can settle first with ctx.Err()
+		reason, err := signal.Get("reason")
+		if err != nil {
+			return err
+		}
+		abortErr, err := abortError(env, reason)
+		if err != nil {
+			return err
+		}
+		if err = deferred.settleNow(env, func(env EnvType) error { return deferred.reject(env, abortErr) }); errors.Is(err, ErrSettled) {
+			return nil // Go function returned before abort
+		}
+		return err
+	}
+
+	if aborted, err := signal.Get("aborted"); err != nil {
+		return err
+	} else if ok, _ := ToBoolean(aborted).Value(); ok {
+		return abort(env, signal)
+	}
+
+	listener, err := CreateFunction(env, "abort", func(ci *CallbackInfo) (ValueType, error) {
+		return nil, abort(ci.Env, ToObject(ci.This))
+	})
+	if err != nil {
+		return err
+	} else if err = callMethod(signal, "addEventListener", "abort", listener); err != nil {
+		return err
+	}
+
+	// Keep signal and listener to remove listener after settle
+	signalRef, status := napi.CreateReference(env.NapiValue(), signal.NapiValue(), 1)
+	if err = statusError(env.NapiValue(), "napi_create_reference", status); err != nil {
+		return err
+	}
+	listenerRef, status := napi.CreateReference(env.NapiValue(), listener.NapiValue(), 1)
+	if err = statusError(env.NapiValue(), "napi_create_reference", status); err != nil {
+		napi.DeleteReference(env.NapiValue(), signalRef)
+		return err
+	}
+	deferred.onSettle = func(env EnvType) {
+		defer napi.DeleteReference(env.NapiValue(), signalRef)
+		defer napi.DeleteReference(env.NapiValue(), listenerRef)
+		signal, status := napi.GetReferenceValue(env.NapiValue(), signalRef)
+		if status != napi.StatusOK {
+			return
+		}
+		listener, status := napi.GetReferenceValue(env.NapiValue(), listenerRef)
+		if status != napi.StatusOK {
+			return
+		}
+		callMethod(ToObject(N_APIValue(env, signal)), "removeEventListener", "abort", N_APIValue(env, listener))
+	}
+	return nil
+}
+
+// Call method of object with event name and listener
+func callMethod(obj *Object, method, event string, listener ValueType) error {
+	fn, err := obj.Get(method)
+	if err != nil {
+		return err
+	}
+	name, err := CreateString(obj.Env(), event)
+	if err != nil {
+		return err
+	}
+	_, err = ToFunction(fn).CallWithGlobal(obj, name, listener)
+	return err
+}
@@ -0,0 +1,141 @@
+package napi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+// Call caller script with Go function and send JSON of resolved value or rejected error to channel
+func callAbort(t *napitest.T, env napi.EnvType, function any, caller string, ch chan<- string) {
+	t.Helper()
+	fn, err := napi.GoFuncOf(env, function)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call, err := napi.CompileFunction(env, `(fn, caller, done) => caller(fn).then(
+	value => done(JSON.stringify({value})),
+	err => done(JSON.stringify({name: err.name, code: err.code, message: err.message, cause: err.cause?.message ?? err.cause})))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done, err := napi.GoFuncOf(env, func(result string) { ch <- result })
+	if err != nil {
+		t.Fatal(err)
+	} else if _, err = call.Call(fn, script(t, env, caller), done); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAbortSignal(t *testing.T) {
+	tests := []struct {
+		name     string
+		function any
+		caller   string
+		want     string
+	}{
+		{"without signal", func(ctx context.Context, n int) int { return n }, `(fn) => fn(1)`, `{"value":1}`},
+		{"signal not aborted", func(ctx context.Context, n int) int { return n }, `(fn) => fn(2, new AbortController().signal)`, `{"value":2}`},
+		{"pre-aborted", func(ctx context.Context, n int) int {
+			panic("Go function called with aborted signal")
+		}, `(fn) => fn(3, AbortSignal.abort("why"))`, `{"name":"AbortError","code":"ABORT_ERR","message":"The operation was aborted","cause":"why"}`},
+		{"pre-aborted error reason", func(ctx context.Context) {}, `(fn) => fn(AbortSignal.abort(new Error("stop")))`,
+			`{"name":"AbortError","code":"ABORT_ERR","message":"The operation was aborted","cause":"stop"}`},
+		{"option", func(ctx context.Context, n int) int { return n }, `(fn) => fn(4, {signal: new AbortController().signal})`, `{"value":4}`},
+		{"option pre-aborted", func(ctx context.Context, n int) int { return n }, `(fn) => fn(5, {signal: AbortSignal.abort("why")})`,
+			`{"name":"AbortError","code":"ABORT_ERR","message":"The operation was aborted","cause":"why"}`},
+		{"option without signal", func(ctx context.Context, n int) int { return n }, `(fn) => fn(6, {signal: "none"})`, `{"value":6}`},
+		{"argument with signal field", func(ctx context.Context, opts struct {
+			Name string `napi:"name"`
+		}) string {
+			return opts.Name
+		}, `(fn) => fn({name: "go", signal: AbortSignal.abort()})`, `{"value":"go"}`},
+		{"variadic", func(ctx context.Context, values ...string) int { return len(values) }, `(fn) => fn("a", "b", AbortSignal.abort())`,
+			`{"name":"AbortError","code":"ABORT_ERR","message":"The operation was aborted","cause":"This operation was aborted"}`},
+		{"error", func(ctx context.Context) error { return errors.New("failed") }, `(fn) => fn(new AbortController().signal)`,
+			`{"name":"Error","message":"failed"}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := make(chan string, 1)
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				callAbort(t, env, test.function, test.caller, results)
+			})
+			if got := wait(t, results); got != test.want {
+				t.Errorf("promise settled with %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAbortSignalRunning(t *testing.T) {
+	started, cancelled := make(chan struct{}), make(chan error, 1)
+	results := make(chan string, 1)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		callAbort(t, env, func(ctx context.Context) (string, error) {
+			close(started)
+			<-ctx.Done()
+			cancelled <- ctx.Err()
+			return "finished", nil // Promise already rejected with AbortError
+		}, `(fn) => fn((globalThis.abortRunning = new AbortController()).signal)`, results)
+	})
+
+	wait(t, started)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		script(t, env, `abortRunning.abort(new Error("stop"))`)
+	})
+	if err := wait(t, cancelled); !errors.Is(err, context.Canceled) {
+		t.Errorf("ctx.Err() = %v, want context.Canceled", err)
+	}
+	if got, want := wait(t, results), `{"name":"AbortError","code":"ABORT_ERR","message":"The operation was aborted","cause":"stop"}`; got != want {
+		t.Errorf("promise settled with %s, want %s", got, want)
+	}
+}
+
+func TestAbortSignalListener(t *testing.T) {
+	release := make(chan struct{})
+	results := make(chan string, 1)
+	listeners := func(t *napitest.T, env napi.EnvType) int {
+		events, err := env.Require("events")
+		if err != nil {
+			t.Fatal(err)
+		}
+		count, err := napi.CompileFunction(env, `(events) => events.getEventListeners(abortListener.signal, "abort").length`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, err := count.Call(events)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, err := napi.ToNumber(value).Int()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return int(n)
+	}
+
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		callAbort(t, env, func(ctx context.Context) int {
+			<-release
+			return 1
+		}, `(fn) => fn((globalThis.abortListener = new AbortController()).signal)`, results)
+		if n := listeners(t, env); n != 1 {
+			t.Errorf("signal has %d abort listeners while running, want 1", n)
+		}
+	})
+	close(release)
+	if got := wait(t, results); got != `{"value":1}` {
+		t.Errorf("promise settled with %s", got)
+	}
+
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		if n := listeners(t, env); n != 0 {
+			t.Errorf("signal has %d abort listeners after settle, want 0", n)
+		}
+		script(t, env, `abortListener.abort()`) // Abort after settle is ignored
+	})
+}
@@ -0,0 +1,262 @@
+package napi
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Optional is value that can be undefined or null, Valid is false if value is undefined or null.
+// Optional arguments of Go functions can be omitted in Javascript.
+//
+//	napi.ExportFunc("greet", func(name string, greeting napi.Optional[string]) string {
+//		return greeting.Or("Hello") + ", " + name
+//	})
+type Optional[T any] struct {
+	Value T
+	Valid bool
+}
+
+// Some return valid Optional with value
+func Some[T any](value T) Optional[T] { return Optional[T]{Value: value, Valid: true} }
+
+// Get return value and if is valid
+func (opt Optional[T]) Get() (T, bool) { return opt.Value, opt.Valid }
+
+// Or return value if valid, else def
+func (opt Optional[T]) Or(def T) T {
+	if opt.Valid {
+		return opt.Value
+	}
+	return def
+}
+
+func (opt *Optional[T]) UnmarshalNapi(value ValueType) error {
+	typeOf, err := value.Type()
+	if err != nil {
+		return err
+	} else if typeOf == TypeUndefined || typeOf == TypeNull {
+		*opt = Optional[T]{}
+		return nil
+	}
+	if err = ValueFrom(value, &opt.Value); err != nil {
+		return err
+	}
+	opt.Valid = true
+	return nil
+}
+
+func (opt Optional[T]) MarshalNapi(env EnvType) (ValueType, error) {
+	if !opt.Valid {
+		return env.Undefined()
+	}
+	return ValueOf(env, opt.Value)
+}
+
+func (Optional[T]) optionalType() reflect.Type { return reflect.TypeFor[T]() }
+
+// This is first parameter of Go function to receive this of Javascript call
+//
+//	napi.ExportFunc("getName", func(this napi.This) (napi.ValueType, error) {
+//		return this.Get("name")
+//	})
+type This struct{ *Object }
+
+var (
+	typeofOptional     = reflect.TypeFor[interface{ optionalType() reflect.Type }]()
+	typeofCallbackInfo = reflect.TypeFor[*CallbackInfo]()
+	typeofThis         = reflect.TypeFor[This]()
+	typeofValueType    = reflect.TypeFor[ValueType]()
+)
+
+// ArgumentError is returned when Javascript argument cannot be converted to parameter of Go function,
+// is thrown in Javascript as TypeError with code ERR_MISSING_ARGS or ERR_INVALID_ARG_TYPE.
+type ArgumentError struct {
+	Index    int    // Position of argument, -1 to this
+	Expected string // Expected Javascript type
+	Received string // Javascript type received, empty if argument is missing
+	Err      error  // Conversion error
+}
+
+func (err *ArgumentError) Error() string {
+	name := fmt.Sprintf("The argument at index %d", err.Index)
+	if err.Index < 0 {
+		name = "The this value"
+	}
+	switch {
+	case err.Received == "" && err.Expected == "":
+		return name + " is required"
+	case err.Received == "":
+		return fmt.Sprintf("%s is required, expected %s", name, err.Expected)
+	case err.Expected == "" || slices.Contains(strings.Split(err.Expected, " or "), err.Received):
+		return fmt.Sprintf("%s is invalid: %s", name, err.Err)
+	}
+	return fmt.Sprintf("%s must be of type %s. Received type %s", name, err.Expected, err.Received)
+}
+
+func (err *ArgumentError) Unwrap() error { return err.Err }
+
+// Code of Node.js error
+func (err *ArgumentError) Code() string {
+	if err.Received == "" {
+		return "ERR_MISSING_ARGS"
+	}
+	return "ERR_INVALID_ARG_TYPE"
+}
+
+func (err *ArgumentError) typeErrorCode() string { return err.Code() }
+
+// Arg decode argument of index to T with [ValueFrom], if argument is missing return error if T is not pointer, interface or [Optional].
+// Errors are [*ArgumentError], thrown as TypeError when returned from [Callback].
+//
+//	name, err := napi.Arg[string](ci, 0)
+//	if err != nil {
+//		return nil, err
+//	}
+func Arg[T any](ci *CallbackInfo, index int) (T, error) {
+	value, err := decodeArgument(ci.Args, index, reflect.TypeFor[T]())
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return *(value.Addr().Interface().(*T)), nil
+}
+
+// ArgOr same of [Arg], return def if argument is missing, undefined or null
+func ArgOr[T any](ci *CallbackInfo, index int, def T) (T, error) {
+	if index >= len(ci.Args) {
+		return def, nil
+	} else if typeOf, err := ci.Args[index].Type(); err != nil {
+		return def, err
+	} else if typeOf == TypeUndefined || typeOf == TypeNull {
+		return def, nil
+	}
+	return Arg[T](ci, index)
+}
+
+// ThisAs decode this of call to T with [ValueFrom], error is [*ArgumentError] with Index -1
+func ThisAs[T any](ci *CallbackInfo) (T, error) {
+	value, err := decodeArgument([]ValueType{ci.This}, 0, reflect.TypeFor[T]())
+	if argErr, ok := err.(*ArgumentError); ok {
+		argErr.Index = -1
+	}
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return *(value.Addr().Interface().(*T)), nil
+}
+
+// ArgsInto decode arguments to exported fields of struct pointed by v, in order of fields,
+// fields with tag `napi:"-"` are ignored. Pointer, interface and [Optional] fields are optional.
+//
+//	var args struct {
+//		Path    string
+//		Options napi.Optional[struct{ Recursive bool }]
+//	}
+//	if err := ci.ArgsInto(&args); err != nil {
+//		return nil, err
+//	}
+func (call *CallbackInfo) ArgsInto(v any) error {
+	ptr := reflect.ValueOf(v)
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() || ptr.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("napi: ArgsInto require pointer to struct, got %T", v)
+	}
+	ptr = ptr.Elem()
+
+	index := 0
+	for fieldIndex := range ptr.NumField() {
+		field := ptr.Type().Field(fieldIndex)
+		if !field.IsExported() || field.Tag.Get(propertiesTagName) == "-" {
+			continue
+		}
+		value, err := decodeArgument(call.Args, index, field.Type)
+		if err != nil {
+			return err
+		}
+		ptr.Field(fieldIndex).Set(value)
+		index++
+	}
+	return nil
+}
+
+// Return true if parameter accept undefined or null and can be omitted in [Arg], [CallbackInfo.ArgsInto] and overloads:
+// pointer, interface and [Optional]. [GoFuncOf] decode omitted trailing arguments of any type to zero value.
+func isOptionalParam(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Interface || typ.Implements(typeofOptional)
+}
+
+// Decode argument of index to Go type, missing optional arguments and undefined or null pointers are zero values,
+// others missing arguments, example string or int, are [*ArgumentError] with ERR_MISSING_ARGS.
+func decodeArgument(args []ValueType, index int, typ reflect.Type) (reflect.Value, error) {
+	value := reflect.New(typ).Elem()
+	if index >= len(args) {
+		if isOptionalParam(typ) {
+			return value, nil
+		}
+		return value, &ArgumentError{Index: index, Expected: jsTypeName(typ)}
+	}
+
+	typeOf, err := args[index].Type()
+	if err != nil {
+		return value, err
+	} else if (typeOf == TypeUndefined || typeOf == TypeNull) && typ.Kind() == reflect.Pointer {
+		return value, nil
+	} else if err = valueFrom(args[index], value); err != nil {
+		return value, &ArgumentError{Index: index, Expected: jsTypeName(typ), Received: typeOf.String(), Err: err}
+	}
+	return value, nil
+}
+
+// Return Javascript types accepted by decode to typ joined with " or ", empty if accept any value
+func jsTypeName(typ reflect.Type) string {
+	names := jsTypes(typ)
+	str := make([]string, len(names))
+	for index, name := range names {
+		str[index] = name.String()
+	}
+	return strings.Join(str, " or ")
+}
+
+// Return Javascript types accepted by decode to typ, nil if accept any value
+func jsTypes(typ reflect.Type) []NapiType {
+	if typ.Implements(typeofOptional) {
+		return jsTypes(reflect.Zero(typ).Interface().(interface{ optionalType() reflect.Type }).optionalType())
+	} else if typ.Implements(typeofValueType) || reflect.PointerTo(typ).Implements(reflect.TypeFor[Unmarshaler]()) {
+		return nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer:
+		return jsTypes(typ.Elem())
+	case reflect.String:
+		return []NapiType{TypeString}
+	case reflect.Bool:
+		return []NapiType{TypeBoolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return []NapiType{TypeNumber, TypeBigInt}
+	case reflect.Float32, reflect.Float64:
+		return []NapiType{TypeNumber}
+	case reflect.Func:
+		return []NapiType{TypeFunction}
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return []NapiType{TypeBuffer, TypeTypedArray, TypeArray}
+		}
+		return []NapiType{TypeArray}
+	case reflect.Map:
+		return []NapiType{TypeObject}
+	case reflect.Struct:
+		if typ == reflect.TypeFor[time.Time]() {
+			return []NapiType{TypeDate}
+		} else if reflect.PointerTo(typ).Implements(reflect.TypeFor[encoding.TextUnmarshaler]()) {
+			return []NapiType{TypeObject, TypeString}
+		}
+		return []NapiType{TypeObject}
+	}
+	return nil
+}
@@ -17,14 +17,15 @@ func CreateArray(env EnvType, size ...int) (*Array, error) {
 	if len(size) > 0 {
 		sizeOf = size[0]
 	}
-	napiValue, err := napi.Value(nil), error(nil)
+	napiValue, status, op := napi.Value(nil), napi.Status(0), "napi_create_array"
 	if sizeOf == 0 {
-		napiValue, err = mustValueErr(napi.CreateArray(env.NapiValue()))
+		napiValue, status = napi.CreateArray(env.NapiValue())
 	} else {
-		napiValue, err = mustValueErr(napi.CreateArrayWithLength(env.NapiValue(), sizeOf))
+		op = "napi_create_array_with_length"
+		napiValue, status = napi.CreateArrayWithLength(env.NapiValue(), sizeOf)
 	}
 	// Check error exists
-	if err != nil {
+	if err := statusError(env.NapiValue(), op, status); err != nil {
 		return nil, err
 	}
 	return ToArray(N_APIValue(env, napiValue)), nil
@@ -32,23 +33,25 @@ func CreateArray(env EnvType, size ...int) (*Array, error) {
 
 // Get array length.
 func (arr *Array) Length() (int, error) {
-	return mustValueErr(napi.GetArrayLength(arr.NapiEnv(), arr.NapiValue()))
+	length, status := napi.GetArrayLength(arr.NapiEnv(), arr.NapiValue())
+	return length, statusError(arr.NapiEnv(), "napi_get_array_length", status)
 }
 
 // Delete index elemente from array.
 func (arr *Array) Delete(index int) (bool, error) {
-	return mustValueErr(napi.DeleteElement(arr.NapiEnv(), arr.NapiValue(), index))
+	ok, status := napi.DeleteElement(arr.NapiEnv(), arr.NapiValue(), index)
+	return ok, statusError(arr.NapiEnv(), "napi_delete_element", status)
 }
 
 // Set value in index
 func (arr *Array) Set(index int, value ValueType) error {
-	return singleMustValueErr(napi.SetElement(arr.NapiEnv(), arr.NapiValue(), index, value.NapiValue()))
+	return statusError(arr.NapiEnv(), "napi_set_element", napi.SetElement(arr.NapiEnv(), arr.NapiValue(), index, value.NapiValue()))
 }
 
 // Get Value from index
 func (arr *Array) Get(index int) (ValueType, error) {
-	napiValue, err := mustValueErr(napi.GetElement(arr.NapiEnv(), arr.NapiValue(), index))
-	if err != nil {
+	napiValue, status := napi.GetElement(arr.NapiEnv(), arr.NapiValue(), index)
+	if err := statusError(arr.NapiEnv(), "napi_get_element", status); err != nil {
 		return nil, err
 	}
 	return N_APIValue(arr.Env(), napiValue), nil
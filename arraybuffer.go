@@ -18,7 +18,7 @@ func ToArrayBuffer(o ValueType) *ArrayBuffer { return &ArrayBuffer{o} }
 // It also returns a pointer to the underlying byte buffer.
 func CreateArrayBuffer(env EnvType, length int) (*ArrayBuffer, []byte, error) {
 	napiValue, dataPtr, status := napi.CreateArrayBuffer(env.NapiValue(), length)
-	if err := status.ToError(); err != nil {
+	if err := statusError(env.NapiValue(), "napi_create_arraybuffer", status); err != nil {
 		return nil, nil, err
 	}
 	var dataSlice []byte
@@ -39,7 +39,7 @@ func CreateExternalArrayBuffer(env EnvType, data []byte, finalize napi.Finalize,
 		dataPtr = unsafe.Pointer(&data[0])
 	}
 	napiValue, status := napi.CreateExternalArrayBuffer(env.NapiValue(), dataPtr, len(data), finalize, finalizeHint)
-	if err := status.ToError(); err != nil {
+	if err := statusError(env.NapiValue(), "napi_create_external_arraybuffer", status); err != nil {
 		return nil, err
 	}
 	return ToArrayBuffer(N_APIValue(env, napiValue)), nil
@@ -48,7 +48,7 @@ func CreateExternalArrayBuffer(env EnvType, data []byte, finalize napi.Finalize,
 // Info retrieves information about the ArrayBuffer, including its underlying data buffer and length.
 func (ab *ArrayBuffer) Info() ([]byte, int, error) {
 	dataPtr, length, status := napi.GetArrayBufferInfo(ab.NapiEnv(), ab.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(ab.NapiEnv(), "napi_get_arraybuffer_info", status); err != nil {
 		return nil, 0, err
 	}
 	var dataSlice []byte
@@ -76,10 +76,11 @@ func (ab *ArrayBuffer) ByteLength() (int, error) {
 // Detach detaches the ArrayBuffer, making its contents inaccessible from JavaScript.
 // This is used for transferring ownership of the underlying buffer.
 func (ab *ArrayBuffer) Detach() error {
-	return singleMustValueErr(napi.DetachArrayBuffer(ab.NapiEnv(), ab.NapiValue()))
+	return statusError(ab.NapiEnv(), "napi_detach_arraybuffer", napi.DetachArrayBuffer(ab.NapiEnv(), ab.NapiValue()))
 }
 
 // IsDetached checks if the ArrayBuffer has been detached.
 func (ab *ArrayBuffer) IsDetached() (bool, error) {
-	return mustValueErr(napi.IsDetachedArrayBuffer(ab.NapiEnv(), ab.NapiValue()))
+	ok, status := napi.IsDetachedArrayBuffer(ab.NapiEnv(), ab.NapiValue())
+	return ok, statusError(ab.NapiEnv(), "napi_is_detached_arraybuffer", status)
 }
@@ -0,0 +1,171 @@
+package napi
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// Function to run in background, progress send value to Javascript callback and
+// result resolve promise of worker, values are converted with [ValueOf].
+type AsyncProgressExec[P, R any] func(progress func(P)) (R, error)
+
+// Send progress values to Javascript thread, only latest value is sent if Javascript thread is busy
+type progressSink[P any] struct {
+	tsfn     *ThreadsafeFunction
+	callback napi.Reference // Javascript progress function
+
+	mu      sync.Mutex
+	latest  P
+	pending bool // latest not delivered
+	done    bool // worker completed
+}
+
+func (sink *progressSink[P]) send(value P) {
+	if sink.tsfn == nil {
+		return
+	}
+	sink.mu.Lock()
+	sink.latest = value
+	if sink.pending || sink.done {
+		sink.mu.Unlock()
+		return
+	}
+	sink.pending = true
+	sink.mu.Unlock()
+	sink.tsfn.Call(nil, NonBlocking) // Queue is unlimited
+}
+
+// Call progress function with latest value, in Javascript thread
+func (sink *progressSink[P]) deliver(env EnvType, callback *Function) {
+	sink.mu.Lock()
+	value, pending := sink.latest, sink.pending
+	sink.pending = false
+	sink.mu.Unlock()
+	if !pending {
+		return
+	}
+
+	jsValue, err := ValueOf(env, value)
+	if err == nil {
+		if jsValue == nil {
+			jsValue, err = env.Undefined()
+		}
+		if err == nil {
+			_, err = callback.Call(jsValue)
+		}
+	}
+	if err != nil && !errors.Is(err, ErrPendingException) {
+		ThrowError(env, "", err.Error())
+	}
+}
+
+// Deliver progress not delivered and release threadsafe function, in complete of worker
+func (sink *progressSink[P]) finish(env EnvType) {
+	if sink.tsfn == nil {
+		return
+	}
+	if callback, status := napi.GetReferenceValue(env.NapiValue(), sink.callback); status == napi.StatusOK {
+		sink.deliver(env, ToFunction(N_APIValue(env, callback)))
+	}
+	sink.mu.Lock()
+	sink.done = true
+	sink.mu.Unlock()
+	napi.DeleteReference(env.NapiValue(), sink.callback)
+	sink.tsfn.Release(Release)
+}
+
+// CreateAsyncProgressWorker run exec in background thread, like [CreateAsyncWorker], values sent to progress
+// are passed to onProgress Javascript function in Javascript thread and result of exec resolve promise of worker.
+// If Javascript thread is busy, progress values are coalesced and onProgress only receive latest value.
+//
+// onProgress can be nil, undefined or null to ignore progress. If exec return error or panic promise is rejected.
+//
+//	worker, err := napi.CreateAsyncProgressWorker(env, ci.Args[0], func(progress func(int)) (string, error) {
+//		for i := range 100 {
+//			progress(i)
+//			time.Sleep(time.Millisecond)
+//		}
+//		return "done", nil
+//	})
+func CreateAsyncProgressWorker[P, R any](env EnvType, onProgress ValueType, exec AsyncProgressExec[P, R]) (*AsyncWorker, error) {
+	sink := &progressSink[P]{}
+	if onProgress != nil {
+		switch typeOf, err := onProgress.Type(); {
+		case err != nil:
+			return nil, err
+		case typeOf == TypeFunction:
+			if sink.tsfn, err = createThreadsafeFunction(env, onProgress.NapiValue(), nil, func(env EnvType, jsCallback *Function, _ any) {
+				sink.deliver(env, jsCallback)
+			}, "napi-go/asyncProgressWorker", 0, 1, nil); err != nil {
+				return nil, err
+			}
+			var status napi.Status
+			if sink.callback, status = napi.CreateReference(env.NapiValue(), onProgress.NapiValue(), 1); status != napi.StatusOK {
+				sink.tsfn.Release(Release)
+				return nil, statusError(env.NapiValue(), "napi_create_reference", status)
+			}
+		case typeOf != TypeUndefined && typeOf != TypeNull:
+			return nil, fmt.Errorf("napi: progress callback require function, got %s", typeOf)
+		}
+	}
+
+	promise, err := CreatePromise(env)
+	if err != nil {
+		sink.finish(env)
+		return nil, err
+	}
+	asyncName, err := CreateString(env, "napi-go/asyncProgressWorker")
+	if err != nil {
+		sink.finish(env)
+		return nil, err
+	}
+
+	var result R
+	var execErr error
+	var asyncWork napi.AsyncWork
+	asyncWork, status := napi.CreateAsyncWork(env.NapiValue(), nil, asyncName.NapiValue(),
+		func(napi.Env) {
+			defer func() {
+				if v := recover(); v != nil {
+					execErr = fmt.Errorf("recover panic: %v", v)
+				}
+			}()
+			result, execErr = exec(sink.send)
+		},
+		func(napiEnv napi.Env, status napi.Status) {
+			defer napi.DeleteAsyncWork(napiEnv, asyncWork)
+			env := N_APIEnv(napiEnv)
+			sink.finish(env) // Progress before result
+
+			if status == napi.StatusCancelled {
+				execErr = fmt.Errorf("async worker canceled")
+			}
+			if execErr == nil {
+				value, err := ValueOf(env, result)
+				if err == nil && value == nil {
+					value, err = env.Undefined()
+				}
+				if err == nil {
+					promise.Resolve(value)
+					return
+				}
+				execErr = err
+			}
+			if value, err := CreateError(env, execErr.Error()); err == nil {
+				promise.Reject(value)
+			}
+		})
+
+	// Check error and start worker
+	if err := statusError(env.NapiValue(), "napi_create_async_work", status); err != nil {
+		sink.finish(env)
+		return nil, err
+	} else if err = statusError(env.NapiValue(), "napi_queue_async_work", napi.QueueAsyncWork(env.NapiValue(), asyncWork)); err != nil {
+		sink.finish(env)
+		return nil, err
+	}
+	return &AsyncWorker{promise.value, asyncWork, promise.promiseDeferred}, nil
+}
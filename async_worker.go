@@ -45,11 +45,6 @@ func CreateAsyncWorker(env EnvType, exec CallbackAsyncWorkerExec, done CallbackA
 					default:
 						err = fmt.Errorf("recover panic: %s", v)
 					}
-					return
-				}
-				ext, status := napi.GetExtendedErrorInfo(env)
-				if status.ToError() == nil {
-					println(ext.Message)
 				}
 			}()
 			exec(N_APIEnv(env))
@@ -109,9 +104,9 @@ func CreateAsyncWorker(env EnvType, exec CallbackAsyncWorkerExec, done CallbackA
 		})
 
 	// Check error and start worker
-	if err := status.ToError(); err != nil {
+	if err := statusError(env.NapiValue(), "napi_create_async_work", status); err != nil {
 		return nil, err
-	} else if err = napi.QueueAsyncWork(env.NapiValue(), asyncWork).ToError(); err != nil {
+	} else if err = statusError(env.NapiValue(), "napi_queue_async_work", napi.QueueAsyncWork(env.NapiValue(), asyncWork)); err != nil {
 		return nil, err
 	}
 
@@ -125,5 +120,5 @@ func CreateAsyncWorker(env EnvType, exec CallbackAsyncWorkerExec, done CallbackA
 // Cancel attempts to cancel the asynchronous work associated with the AsyncWorker.
 // It returns an error if the cancellation fails or if the async work cannot be cancelled.
 func (async *AsyncWorker) Cancel() error {
-	return napi.CancelAsyncWork(async.NapiEnv(), async.asyncWork).ToError()
+	return statusError(async.NapiEnv(), "napi_cancel_async_work", napi.CancelAsyncWork(async.NapiEnv(), async.asyncWork))
 }
@@ -0,0 +1,171 @@
+package napi_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+// Wait value from channel or fail test after timeout
+func wait[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case value := <-ch:
+		return value
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting Javascript thread")
+		panic("unreachable")
+	}
+}
+
+func TestAsyncWorker(t *testing.T) {
+	results := make(chan int64, 1)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		var sum int
+		_, err := napi.CreateAsyncWorker(env, func(env napi.EnvType) {
+			for n := range 101 {
+				sum += n
+			}
+		}, func(env napi.EnvType, resolve, reject func(value napi.ValueType)) {
+			value, err := napi.ValueOf(env, sum)
+			if err != nil {
+				t.Error(err)
+				reject(nil)
+				return
+			}
+			resolve(value)
+			n, err := napi.ToNumber(value).Int()
+			if err != nil {
+				t.Error(err)
+			}
+			results <- n
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	if sum := wait(t, results); sum != 5050 {
+		t.Errorf("got %d, want 5050", sum)
+	}
+}
+
+func TestAsyncWorkerPanic(t *testing.T) {
+	rejected := make(chan string, 1)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		worker, err := napi.CreateAsyncWorker(env, func(napi.EnvType) {
+			panic(errors.New("worker failed"))
+		}, func(napi.EnvType, func(napi.ValueType), func(napi.ValueType)) {
+			t.Error("done called after panic in exec")
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		catch, err := napi.CompileFunction(env, `(promise, done) => promise.catch(err => done(err.message))`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		done, err := napi.GoFuncOf(env, func(message string) { rejected <- message })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = catch.Call(worker, done); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if message := wait(t, rejected); message != "worker failed" {
+		t.Errorf("promise rejected with %q, want %q", message, "worker failed")
+	}
+}
+
+func TestAsyncProgressWorker(t *testing.T) {
+	type result struct {
+		Total int    `napi:"total"`
+		Name  string `napi:"name"`
+	}
+	events := make(chan string, 10)
+	results := make(chan settled, 1)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		onProgress, err := napi.GoFuncOf(env, func(n int) { events <- fmt.Sprint("progress ", n) })
+		if err != nil {
+			t.Fatal(err)
+		}
+		sent := make(chan struct{})
+		worker, err := napi.CreateAsyncProgressWorker(env, onProgress, func(progress func(int)) (result, error) {
+			for n := range 101 {
+				progress(n)
+			}
+			close(sent)
+			return result{100, "done"}, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		await(t, env, worker, results)
+		wait(t.T, sent) // Javascript thread busy, progress coalesced to latest value
+	})
+
+	if got := wait(t, events); got != "progress 100" {
+		t.Errorf("onProgress called with %q, want latest value %q", got, "progress 100")
+	}
+	if got, want := wait(t, results), (settled{"resolved", `{"total":100,"name":"done"}`}); got != want {
+		t.Errorf("promise %s %s, want %s %s", got.State, got.Value, want.State, want.Value)
+	}
+	select {
+	case got := <-events:
+		t.Errorf("onProgress called again with %q", got)
+	default:
+	}
+}
+
+func TestAsyncProgressWorkerError(t *testing.T) {
+	tests := []struct {
+		name   string
+		worker func(env napi.EnvType) (*napi.AsyncWorker, error)
+		want   string
+	}{
+		{"error", func(env napi.EnvType) (*napi.AsyncWorker, error) {
+			return napi.CreateAsyncProgressWorker(env, nil, func(progress func(int)) (int, error) {
+				progress(1)
+				return 0, errors.New("worker failed")
+			})
+		}, "worker failed"},
+		{"panic", func(env napi.EnvType) (*napi.AsyncWorker, error) {
+			return napi.CreateAsyncProgressWorker(env, nil, func(func(int)) (int, error) { panic("worker panic") })
+		}, "recover panic: worker panic"},
+		{"result not convertible", func(env napi.EnvType) (*napi.AsyncWorker, error) {
+			return napi.CreateAsyncProgressWorker(env, nil, func(func(int)) (failMarshal, error) { return failMarshal{}, nil })
+		}, "cannot marshal"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := make(chan settled, 1)
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				worker, err := test.worker(env)
+				if err != nil {
+					t.Fatal(err)
+				}
+				await(t, env, worker, results)
+			})
+			if got, want := wait(t, results), (settled{"rejected", test.want}); got != want {
+				t.Errorf("promise %s %s, want %s %s", got.State, got.Value, want.State, want.Value)
+			}
+		})
+	}
+
+	t.Run("progress not function", func(t *testing.T) {
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+			onProgress, err := napi.CreateString(env, "progress")
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = napi.CreateAsyncProgressWorker(env, onProgress, func(func(int)) (int, error) { return 0, nil })
+			if err == nil || err.Error() != "napi: progress callback require function, got string" {
+				t.Errorf("CreateAsyncProgressWorker error = %v", err)
+			}
+		})
+	})
+}
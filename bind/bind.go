@@ -0,0 +1,434 @@
+// Package bind is used by code generated with "napi-go bind" to convert values without reflection,
+// conversions follow same rules of [napi.ValueOf], [napi.ValueFrom] and [napi.GoFuncOf].
+//
+// Decoders have type func(napi.ValueType) (T, error) and encoders func(napi.EnvType, T) (napi.ValueType, error),
+// slices, maps and pointers are composed from decoder or encoder of element:
+//
+//	decode := bind.DecodeSlice(bind.DecodeInt[int])
+//	numbers, err := decode(value)
+package bind
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+)
+
+// Decoder convert Javascript value to Go value
+type Decoder[T any] func(value napi.ValueType) (T, error)
+
+// Encoder convert Go value to Javascript value
+type Encoder[T any] func(env napi.EnvType, value T) (napi.ValueType, error)
+
+// Same error returned by [napi.ValueFrom], reflect is only used to build error message
+func cannotSet[T any](typeOf napi.NapiType) error {
+	return fmt.Errorf("cannot set %s, to %s", typeOf, reflect.TypeFor[T]().Kind())
+}
+
+// Arg decode argument of index with decode, same of [napi.GoFuncOf] arguments: missing argument is zero value.
+// Errors are [*napi.ArgumentError], thrown as TypeError, reflect is only used to build error.
+func Arg[T any](args []napi.ValueType, index int, decode Decoder[T]) (T, error) {
+	var value T
+	if index >= len(args) {
+		return value, nil
+	}
+	value, err := decode(args[index])
+	if err != nil {
+		return value, argumentError[T](args, index)
+	}
+	return value, nil
+}
+
+// Return error of [napi.Arg] with same rules of decoders
+func argumentError[T any](args []napi.ValueType, index int) error {
+	if _, err := napi.Arg[T](&napi.CallbackInfo{Args: args}, index); err != nil {
+		return err
+	}
+	typeOf, _ := args[index].Type()
+	return &napi.ArgumentError{Index: index, Received: typeOf.String(), Err: cannotSet[T](typeOf)}
+}
+
+// Variadic decode arguments from index to variadic parameter
+func Variadic[T any](args []napi.ValueType, index int, decode Decoder[T]) ([]T, error) {
+	slice := make([]T, 0, max(len(args)-index, 0))
+	for ; index < len(args); index++ {
+		value, err := Arg(args, index, decode)
+		if err != nil {
+			return nil, err
+		}
+		slice = append(slice, value)
+	}
+	return slice, nil
+}
+
+// MarshalFunc is [napi.Marshaler] calling function, used to convert results of Go functions
+// with context.Context in Javascript thread, see [napi.CallContext].
+type MarshalFunc func(env napi.EnvType) (napi.ValueType, error)
+
+func (fn MarshalFunc) MarshalNapi(env napi.EnvType) (napi.ValueType, error) { return fn(env) }
+
+// Return Javascript array with values, used to functions with multiple returns
+func Tuple(env napi.EnvType, values ...napi.ValueType) (napi.ValueType, error) {
+	arr, err := napi.CreateArray(env, len(values))
+	if err != nil {
+		return nil, err
+	}
+	for index, value := range values {
+		if err = arr.Set(index, value); err != nil {
+			return nil, err
+		}
+	}
+	return arr, nil
+}
+
+// DecodeString decode Javascript string
+func DecodeString[T ~string](value napi.ValueType) (T, error) {
+	typeOf, err := value.Type()
+	if err != nil {
+		return "", err
+	} else if typeOf != napi.TypeString {
+		return "", cannotSet[T](typeOf)
+	}
+	str, err := napi.ToString(value).Utf8Value()
+	return T(str), err
+}
+
+// DecodeBool decode Javascript boolean
+func DecodeBool[T ~bool](value napi.ValueType) (T, error) {
+	typeOf, err := value.Type()
+	if err != nil {
+		return false, err
+	} else if typeOf != napi.TypeBoolean {
+		return false, cannotSet[T](typeOf)
+	}
+	b, err := napi.ToBoolean(value).Value()
+	return T(b), err
+}
+
+// DecodeInt decode Javascript number or bigint to signed integer
+func DecodeInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](value napi.ValueType) (T, error) {
+	n, err := decodeInteger[T](value)
+	return T(n), err
+}
+
+// DecodeUint decode Javascript number or bigint to unsigned integer
+func DecodeUint[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr](value napi.ValueType) (T, error) {
+	n, err := decodeInteger[T](value)
+	return T(n), err
+}
+
+func decodeInteger[T any](value napi.ValueType) (int64, error) {
+	typeOf, err := value.Type()
+	if err != nil {
+		return 0, err
+	}
+	switch typeOf {
+	case napi.TypeNumber:
+		return napi.ToNumber(value).Int()
+	case napi.TypeBigInt:
+		return napi.ToBigint(value).Int64()
+	}
+	return 0, cannotSet[T](typeOf)
+}
+
+// DecodeFloat decode Javascript number to float
+func DecodeFloat[T ~float32 | ~float64](value napi.ValueType) (T, error) {
+	typeOf, err := value.Type()
+	if err != nil {
+		return 0, err
+	} else if typeOf != napi.TypeNumber {
+		return 0, cannotSet[T](typeOf)
+	}
+	f, err := napi.ToNumber(value).Float()
+	return T(f), err
+}
+
+// DecodeBytes decode Buffer, Uint8Array or array of numbers to []byte
+func DecodeBytes(value napi.ValueType) ([]byte, error) {
+	typeOf, err := value.Type()
+	if err != nil {
+		return nil, err
+	} else if typeOf == napi.TypeBuffer || typeOf == napi.TypeTypedArray {
+		data, err := napi.ToBuffer(value).Data()
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{}, data...), nil
+	}
+	return DecodeSlice(DecodeUint[byte])(value)
+}
+
+// DecodeTime decode Javascript Date, object is zero time same of [napi.ValueFrom] and [EncodeTime]
+func DecodeTime(value napi.ValueType) (time.Time, error) {
+	typeOf, err := value.Type()
+	if err != nil {
+		return time.Time{}, err
+	} else if typeOf == napi.TypeObject {
+		return time.Time{}, nil
+	} else if typeOf != napi.TypeDate {
+		return time.Time{}, cannotSet[time.Time](typeOf)
+	}
+	return napi.ToDate(value).Time()
+}
+
+// DecodeSlice return decoder of Javascript array to slice
+func DecodeSlice[T any](decode Decoder[T]) Decoder[[]T] {
+	return func(value napi.ValueType) ([]T, error) {
+		typeOf, err := value.Type()
+		if err != nil {
+			return nil, err
+		} else if typeOf != napi.TypeArray {
+			return nil, cannotSet[[]T](typeOf)
+		}
+		arr := napi.ToArray(value)
+		size, err := arr.Length()
+		if err != nil {
+			return nil, err
+		}
+		slice := make([]T, size)
+		for index := range size {
+			item, err := arr.Get(index)
+			if err != nil {
+				return nil, err
+			} else if slice[index], err = decode(item); err != nil {
+				return nil, err
+			}
+		}
+		return slice, nil
+	}
+}
+
+// DecodeMap return decoder of Javascript object to map with string key
+func DecodeMap[K ~string, V any](decode Decoder[V]) Decoder[map[K]V] {
+	return func(value napi.ValueType) (map[K]V, error) {
+		typeOf, err := value.Type()
+		if err != nil {
+			return nil, err
+		} else if typeOf != napi.TypeObject {
+			return nil, cannotSet[map[K]V](typeOf)
+		}
+		goMap := map[K]V{}
+		for key, item := range napi.ToObject(value).Seq() {
+			if goMap[K(key)], err = decode(item); err != nil {
+				return nil, err
+			}
+		}
+		return goMap, nil
+	}
+}
+
+// DecodePointer return decoder to pointer of T
+func DecodePointer[T any](decode Decoder[T]) Decoder[*T] {
+	return func(value napi.ValueType) (*T, error) {
+		v, err := decode(value)
+		if err != nil {
+			return nil, err
+		}
+		return &v, nil
+	}
+}
+
+// DecodeNullable return decoder returning zero value to undefined and null, used to pointer arguments
+func DecodeNullable[T any](decode Decoder[T]) Decoder[T] {
+	return func(value napi.ValueType) (T, error) {
+		if typeOf, err := value.Type(); err != nil || typeOf == napi.TypeUndefined || typeOf == napi.TypeNull {
+			var zero T
+			return zero, err
+		}
+		return decode(value)
+	}
+}
+
+// DecodeUnmarshaler decode value with [napi.Unmarshaler] of *T
+func DecodeUnmarshaler[T any, P interface {
+	*T
+	napi.Unmarshaler
+}](value napi.ValueType) (T, error) {
+	var v T
+	err := P(&v).UnmarshalNapi(value)
+	return v, err
+}
+
+// DecodeAny decode value with [napi.ValueFrom], used to types without decoder
+func DecodeAny[T any](value napi.ValueType) (T, error) {
+	var v T
+	err := napi.ValueFrom(value, &v)
+	return v, err
+}
+
+// DecodeObject return object if value is Javascript object, kind is Go kind to error message
+func DecodeObject(value napi.ValueType, kind string) (*napi.Object, error) {
+	typeOf, err := value.Type()
+	if err != nil {
+		return nil, err
+	} else if typeOf != napi.TypeObject {
+		return nil, fmt.Errorf("cannot set %s, to %s", typeOf, kind)
+	}
+	return napi.ToObject(value), nil
+}
+
+// Field return property value of object and if exists
+func Field(obj *napi.Object, key string) (napi.ValueType, bool, error) {
+	if ok, _ := obj.Has(key); !ok {
+		return nil, false, nil
+	}
+	value, err := obj.Get(key)
+	return value, err == nil, err
+}
+
+// EncodeString create Javascript string
+func EncodeString[T ~string](env napi.EnvType, value T) (napi.ValueType, error) {
+	return napi.CreateString(env, string(value))
+}
+
+// EncodeBool create Javascript boolean
+func EncodeBool[T ~bool](env napi.EnvType, value T) (napi.ValueType, error) {
+	return napi.CreateBoolean(env, bool(value))
+}
+
+// EncodeNumber create Javascript number
+func EncodeNumber[T ~int | ~uint | ~int8 | ~uint8 | ~int16 | ~uint16 | ~int32 | ~uint32 | ~float32 | ~float64](env napi.EnvType, value T) (napi.ValueType, error) {
+	return napi.CreateNumber(env, value)
+}
+
+// EncodeInt64 create Javascript bigint from int64
+func EncodeInt64[T ~int64](env napi.EnvType, value T) (napi.ValueType, error) {
+	return napi.CreateBigint(env, int64(value))
+}
+
+// EncodeUint64 create Javascript bigint from uint64
+func EncodeUint64[T ~uint64](env napi.EnvType, value T) (napi.ValueType, error) {
+	return napi.CreateBigint(env, uint64(value))
+}
+
+// EncodeBytes create Buffer with copy of data
+func EncodeBytes(env napi.EnvType, value []byte) (napi.ValueType, error) {
+	return napi.CopyBuffer(env, value)
+}
+
+// EncodeTime create Javascript Date, zero time is a empty object same of [napi.ValueOf]
+func EncodeTime(env napi.EnvType, value time.Time) (napi.ValueType, error) {
+	if value.IsZero() {
+		return napi.CreateObject(env)
+	}
+	return napi.CreateDate(env, value)
+}
+
+// EncodeSlice return encoder of slice to Javascript array
+func EncodeSlice[T any](encode Encoder[T]) Encoder[[]T] {
+	return func(env napi.EnvType, value []T) (napi.ValueType, error) {
+		arr, err := napi.CreateArray(env, len(value))
+		if err != nil {
+			return nil, err
+		}
+		for index, item := range value {
+			jsValue, err := encode(env, item)
+			if err != nil {
+				return nil, err
+			} else if err = arr.Set(index, jsValue); err != nil {
+				return nil, err
+			}
+		}
+		return arr, nil
+	}
+}
+
+// EncodeMap return encoder of map with string key to Javascript object
+func EncodeMap[K ~string, V any](encode Encoder[V]) Encoder[map[K]V] {
+	return func(env napi.EnvType, value map[K]V) (napi.ValueType, error) {
+		obj, err := napi.CreateObject(env)
+		if err != nil {
+			return nil, err
+		}
+		for key, item := range value {
+			jsValue, err := encode(env, item)
+			if err != nil {
+				return nil, err
+			} else if err = obj.Set(string(key), jsValue); err != nil {
+				return nil, err
+			}
+		}
+		return obj, nil
+	}
+}
+
+// EncodePointer return encoder of pointer, nil pointer is undefined
+func EncodePointer[T any](encode Encoder[T]) Encoder[*T] {
+	return func(env napi.EnvType, value *T) (napi.ValueType, error) {
+		if value == nil {
+			return env.Undefined()
+		}
+		return encode(env, *value)
+	}
+}
+
+// EncodeMarshaler encode value with [napi.Marshaler]
+func EncodeMarshaler[T napi.Marshaler](env napi.EnvType, value T) (napi.ValueType, error) {
+	return value.MarshalNapi(env)
+}
+
+// EncodeValue return Javascript value as is, nil is undefined
+func EncodeValue[T napi.ValueType](env napi.EnvType, value T) (napi.ValueType, error) {
+	var zero T // nil interface or nil pointer, example *napi.String
+	if any(value) == any(zero) {
+		return env.Undefined()
+	}
+	return value, nil
+}
+
+// EncodeAny encode value with [napi.ValueOf], used to types without encoder, nil interface is null
+func EncodeAny[T any](env napi.EnvType, value T) (napi.ValueType, error) {
+	if any(value) == nil {
+		return env.Null()
+	}
+	return napi.ValueOf(env, value)
+}
+
+// SetField set property in object, option is "omitempty" or "omitzero" from napi tag
+// to skip empty values same of [napi.ValueOf].
+func SetField(obj *napi.Object, key, option string, value napi.ValueType) error {
+	if option != "" {
+		skip, err := omit(value, option)
+		if err != nil || skip {
+			return err
+		}
+	}
+	return obj.Set(key, value)
+}
+
+func omit(value napi.ValueType, option string) (bool, error) {
+	typeOf, err := value.Type()
+	if err != nil {
+		return false, err
+	}
+	switch typeOf {
+	case napi.TypeUndefined, napi.TypeNull, napi.TypeUnkown:
+		return option == "omitempty" || option == "omitzero", nil
+	}
+
+	switch option {
+	case "omitempty":
+		if typeOf == napi.TypeString {
+			str, err := napi.ToString(value).Utf8Value()
+			return str == "", err
+		}
+	case "omitzero":
+		switch typeOf {
+		case napi.TypeDate:
+			date, err := napi.ToDate(value).Time()
+			return date.Unix() == 0, err
+		case napi.TypeBigInt:
+			n, err := napi.ToBigint(value).Int64()
+			return n == 0, err
+		case napi.TypeNumber:
+			n, err := napi.ToNumber(value).Int()
+			return n == 0, err
+		case napi.TypeArray:
+			n, err := napi.ToArray(value).Length()
+			return n == 0, err
+		}
+	}
+	return false, nil
+}
@@ -12,15 +12,25 @@ func ToBoolean(o ValueType) *Boolean { return &Boolean{o} }
 // Returns a pointer to a Boolean object representing the value in the N-API environment,
 // or an error if the creation fails.
 func CreateBoolean(env EnvType, value bool) (*Boolean, error) {
-	v, err := mustValueErr(napi.GetBoolean(env.NapiValue(), value))
-	if err != nil {
+	v, status := napi.GetBoolean(env.NapiValue(), value)
+	if err := statusError(env.NapiValue(), "napi_get_boolean", status); err != nil {
 		return nil, err
 	}
 	return ToBoolean(N_APIValue(env, v)), nil
 }
 
+// CoerceBoolean converts any [ValueType] to [*Boolean], same as Boolean(value) in Javascript.
+func CoerceBoolean(value ValueType) (*Boolean, error) {
+	napiValue, status := napi.CoerceToBool(value.NapiEnv(), value.NapiValue())
+	if err := statusError(value.NapiEnv(), "napi_coerce_to_bool", status); err != nil {
+		return nil, err
+	}
+	return ToBoolean(N_APIValue(value.Env(), napiValue)), nil
+}
+
 // Value retrieves the boolean value represented by the Boolean object.
 // It returns the Go bool value and an error if the underlying N-API call fails.
 func (bo *Boolean) Value() (bool, error) {
-	return mustValueErr(napi.GetValueBool(bo.NapiEnv(), bo.NapiValue()))
+	v, status := napi.GetValueBool(bo.NapiEnv(), bo.NapiValue())
+	return v, statusError(bo.NapiEnv(), "napi_get_value_bool", status)
 }
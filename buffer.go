@@ -9,8 +9,8 @@ func ToBuffer(o ValueType) *Buffer { return &Buffer{o} }
 
 // Create new Buffer with length
 func CreateBuffer(env EnvType, length int) (*Buffer, error) {
-	napiValue, err := mustValueErr(napi.CreateBuffer(env.NapiValue(), length))
-	if err != nil {
+	napiValue, status := napi.CreateBuffer(env.NapiValue(), length)
+	if err := statusError(env.NapiValue(), "napi_create_buffer", status); err != nil {
 		return nil, err
 	}
 	return ToBuffer(N_APIValue(env, napiValue)), nil
@@ -18,8 +18,8 @@ func CreateBuffer(env EnvType, length int) (*Buffer, error) {
 
 // Copy []byte to Node::Buffer struct
 func CopyBuffer(env EnvType, buff []byte) (*Buffer, error) {
-	napiValue, err := mustValueErr(napi.CreateBufferCopy(env.NapiValue(), buff))
-	if err != nil {
+	napiValue, status := napi.CreateBufferCopy(env.NapiValue(), buff)
+	if err := statusError(env.NapiValue(), "napi_create_buffer_copy", status); err != nil {
 		return nil, err
 	}
 	return ToBuffer(N_APIValue(env, napiValue)), nil
@@ -27,10 +27,12 @@ func CopyBuffer(env EnvType, buff []byte) (*Buffer, error) {
 
 // Get size of buffer
 func (buff *Buffer) Length() (int, error) {
-	return mustValueErr(napi.GetBufferInfoSize(buff.NapiEnv(), buff.NapiValue()))
+	length, status := napi.GetBufferInfoSize(buff.NapiEnv(), buff.NapiValue())
+	return length, statusError(buff.NapiEnv(), "napi_get_buffer_info", status)
 }
 
 // return []byte from Buffer value
 func (buff *Buffer) Data() ([]byte, error) {
-	return mustValueErr(napi.GetBufferInfoData(buff.NapiEnv(), buff.NapiValue()))
+	data, status := napi.GetBufferInfoData(buff.NapiEnv(), buff.NapiValue())
+	return data, statusError(buff.NapiEnv(), "napi_get_buffer_info", status)
 }
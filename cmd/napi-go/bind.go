@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/bindgen"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/pkgload"
+)
+
+const bindUsage = `usage: napi-go bind [flags] [package]
+
+Generate napi.Callback to functions and MarshalNapi/UnmarshalNapi to structs
+with "//napi:bind" directive without reflection, with same behaviour of
+napi.GoFuncOf, napi.ValueOf and napi.ValueFrom. Use with go generate:
+
+	//go:generate go run sirherobrine23.com.br/Sirherobrine23/napi-go/cmd/napi-go bind
+
+	//napi:bind
+	func Sum(a, b int) int { return a + b }
+
+	fn, err := napi.CreateFunction(env, "sum", SumCallback)
+
+Flags:
+`
+
+func runBind(args []string) error {
+	flags := flag.NewFlagSet("bind", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), bindUsage)
+		flags.PrintDefaults()
+	}
+	output := flags.String("o", "napi_bind.go", "output file, relative to package directory")
+	flags.Parse(args)
+
+	pattern := "."
+	switch flags.NArg() {
+	case 0:
+	case 1:
+		pattern = flags.Arg(0)
+	default:
+		return fmt.Errorf("only one package can be generated")
+	}
+
+	// Package can reference generated code not exists yet
+	pkg, err := pkgload.Load(".", pattern, true)
+	if err != nil {
+		return err
+	}
+	src, err := bindgen.Generate(pkg)
+	if err != nil {
+		return err
+	}
+
+	file := *output
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(pkg.Dir, file)
+	}
+	return os.WriteFile(file, src, 0644)
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+const buildUsage = `usage: napi-go build [flags] [package] [-- go build flags]
+
+Build Go package as Node.js addon (-buildmode=c-shared) to each target in output directory:
+
+	<out>/prebuilds/<process.platform>-<process.arch>/<name>.node, ppc64le is <process.platform>-ppc64le
+	<out>/index.js      loader to select prebuilt addon to running Node.js
+	<out>/index.d.ts    types generated from package, existing file not generated by napi-go is kept
+	<out>/package.json  with "main" and "types", existing fields are kept
+
+C compiler for each target is selected in order: CC_<GOOS>_<GOARCH> env, example CC_linux_arm64="aarch64-linux-gnu-gcc",
+"zig cc -target <triple>" with -zig flag, and CC env.
+
+Windows targets are not supported, addon must link with node.lib import library.
+
+Flags:
+`
+
+type buildOptions struct {
+	Package string   // Go package to build
+	Name    string   // Addon name
+	Out     string   // Output directory
+	Targets []target // GOOS/GOARCH to build
+	Tags    string   // Go build tags
+	Zig     bool     // Use zig cc to cross compile
+	GoFlags []string // Extra go build flags
+	Verbose bool
+}
+
+func runBuild(args []string) error {
+	var opts buildOptions
+	var targets string
+
+	flags := flag.NewFlagSet("build", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), buildUsage)
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&opts.Out, "o", "build", "output directory")
+	flags.StringVar(&opts.Name, "name", "", "addon name, default is the last element of package import path")
+	flags.StringVar(&targets, "targets", "", "comma-separated list of GOOS/GOARCH, example \"linux/amd64,linux/arm64,darwin/arm64\" (default host)")
+	flags.StringVar(&opts.Tags, "tags", "", "comma-separated list of build tags, example \"napi8\"")
+	flags.BoolVar(&opts.Zig, "zig", false, "cross compile with \"zig cc\"")
+	flags.BoolVar(&opts.Verbose, "v", false, "print go commands")
+	flags.Parse(args)
+
+	var err error
+	if opts.Targets, err = parseTargets(targets); err != nil {
+		return err
+	}
+
+	args = flags.Args()
+	if index := slices.Index(args, "--"); index >= 0 {
+		opts.GoFlags, args = args[index+1:], args[:index]
+	}
+	switch len(args) {
+	case 0:
+		opts.Package = "."
+	case 1:
+		opts.Package = args[0]
+	default:
+		return fmt.Errorf("only one package can be built")
+	}
+
+	return build(opts)
+}
+
+func build(opts buildOptions) error {
+	if opts.Name == "" {
+		importPath, err := goList(opts.Package)
+		if err != nil {
+			return err
+		}
+		opts.Name = path.Base(importPath)
+	}
+
+	if err := os.MkdirAll(opts.Out, 0755); err != nil {
+		return err
+	}
+
+	var prebuilds []prebuild
+	for _, target := range opts.Targets {
+		file := filepath.Join("prebuilds", target.nodeKey(), opts.Name+".node")
+		if err := buildTarget(opts, target, filepath.Join(opts.Out, file)); err != nil {
+			return fmt.Errorf("%s: %w", target, err)
+		}
+		prebuilds = append(prebuilds, prebuild{target.nodeKey(), "./" + filepath.ToSlash(file)})
+	}
+
+	if err := writeLoader(opts, prebuilds); err != nil {
+		return err
+	}
+	return writePackageJSON(opts)
+}
+
+// Return import path of package
+func goList(pkg string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "list", "-f", "{{.ImportPath}}", pkg)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("go list %s: %s", pkg, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// Build target with go build -buildmode=c-shared
+func buildTarget(opts buildOptions, target target, output string) error {
+	args := []string{"build", "-buildmode=c-shared", "-o", output}
+	if opts.Tags != "" {
+		args = append(args, "-tags", opts.Tags)
+	}
+	args = append(args, opts.GoFlags...)
+	args = append(args, opts.Package)
+
+	cmd := exec.Command("go", args...)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	cmd.Env = append(os.Environ(), "CGO_ENABLED=1", "GOOS="+target.GOOS, "GOARCH="+target.GOARCH)
+
+	cc := os.Getenv(fmt.Sprintf("CC_%s_%s", target.GOOS, target.GOARCH))
+	if cc == "" && opts.Zig {
+		var err error
+		if cc, err = target.zigCC(); err != nil {
+			return err
+		}
+	}
+	if cc != "" {
+		cmd.Env = append(cmd.Env, "CC="+cc)
+	} else if host, err := goEnvTarget("GOHOSTOS", "GOHOSTARCH"); err == nil && os.Getenv("CC") == "" && target != host {
+		fmt.Fprintf(os.Stderr, "napi-go build: %s: cross compiling without CC, set CC_%s_%s or use -zig\n", target, target.GOOS, target.GOARCH)
+	}
+
+	if opts.Verbose {
+		fmt.Fprintf(os.Stderr, "GOOS=%s GOARCH=%s CC=%q go %s\n", target.GOOS, target.GOARCH, cc, strings.Join(args, " "))
+	}
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+
+	// c-shared write C header with exported functions, not needed to Node.js
+	header := strings.TrimSuffix(output, filepath.Ext(output)) + ".h"
+	if err := os.Remove(header); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// First line of TypeScript declarations generated by napi-go
+const generatedTypes = "// Code generated by napi-go dts. DO NOT EDIT.\n"
+
+type prebuild struct {
+	Key  string // process.platform-process.arch
+	Path string // Path relative to loader
+}
+
+var loaderTemplate = template.Must(template.New("index.js").Parse(`// Code generated by napi-go build. DO NOT EDIT.
+"use strict";
+
+function load() {
+	let arch = process.arch;
+	if (arch === "ppc64" && require("os").endianness() === "LE") arch = "ppc64le";
+	switch (process.platform + "-" + arch) {
+{{- range .Prebuilds}}
+		case {{printf "%q" .Key}}: return require({{printf "%q" .Path}});
+{{- end}}
+	}
+	throw new Error({{printf "%q" .Name}} + ": no prebuilt addon to " + process.platform + "-" + arch + ", available: {{.Available}}");
+}
+
+module.exports = load();
+`))
+
+// Write index.js loader and index.d.ts if not exists
+func writeLoader(opts buildOptions, prebuilds []prebuild) error {
+	var keys []string
+	for _, prebuild := range prebuilds {
+		keys = append(keys, prebuild.Key)
+	}
+
+	var loader bytes.Buffer
+	err := loaderTemplate.Execute(&loader, map[string]any{
+		"Name":      opts.Name,
+		"Prebuilds": prebuilds,
+		"Available": strings.Join(keys, ", "),
+	})
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(opts.Out, "index.js"), loader.Bytes(), 0644); err != nil {
+		return err
+	}
+
+	// Only replace index.d.ts writed by napi-go
+	types := filepath.Join(opts.Out, "index.d.ts")
+	if data, err := os.ReadFile(types); err == nil && !bytes.HasPrefix(data, []byte(generatedTypes)) {
+		return nil
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	data, err := generateTypes(opts.Package)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "napi-go build: cannot generate types: %s\n", err)
+		data = []byte(generatedTypes + "\ndeclare const addon: any;\nexport = addon;\n")
+	}
+	return os.WriteFile(types, data, 0644)
+}
+
+// Write package.json keeping existing fields
+func writePackageJSON(opts buildOptions) error {
+	file := filepath.Join(opts.Out, "package.json")
+	pkg := map[string]any{}
+	if data, err := os.ReadFile(file); err == nil {
+		if err := json.Unmarshal(data, &pkg); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if _, ok := pkg["name"]; !ok {
+		pkg["name"] = opts.Name
+	}
+	if _, ok := pkg["version"]; !ok {
+		pkg["version"] = "0.0.0"
+	}
+	pkg["main"] = "index.js"
+	pkg["types"] = "index.d.ts"
+
+	var platforms, archs []string
+	for _, target := range opts.Targets {
+		platform, arch, _ := target.node()
+		if arch == "ppc64le" {
+			arch = "ppc64" // process.arch
+		}
+		if !slices.Contains(platforms, platform) {
+			platforms = append(platforms, platform)
+		}
+		if !slices.Contains(archs, arch) {
+			archs = append(archs, arch)
+		}
+	}
+	pkg["os"], pkg["cpu"] = platforms, archs
+
+	data, err := json.MarshalIndent(pkg, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, append(data, '\n'), 0644)
+}
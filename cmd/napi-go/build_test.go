@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Run Javascript source with node and return stdout
+func runNode(t *testing.T, dir, source string) string {
+	t.Helper()
+	node, err := exec.LookPath("node")
+	if err != nil {
+		t.Skip("node not found")
+	}
+	cmd := exec.Command(node, "-e", source)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("node: %s\n%s", err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestWriteLoader(t *testing.T) {
+	dir := t.TempDir()
+	var prebuilds []prebuild
+	for _, target := range []target{{"linux", "amd64"}, {"linux", "ppc64le"}, {"aix", "ppc64"}} {
+		file := filepath.Join("prebuilds", target.nodeKey(), "addon.js")
+		if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(file)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, file), []byte("module.exports = "+`"`+target.String()+`";`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		prebuilds = append(prebuilds, prebuild{target.nodeKey(), "./" + filepath.ToSlash(file)})
+	}
+	// index.d.ts not generated by napi-go is kept
+	types := "declare const addon: string;\nexport = addon;\n"
+	if err := os.WriteFile(filepath.Join(dir, "index.d.ts"), []byte(types), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeLoader(buildOptions{Name: "addon", Out: dir}, prebuilds); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := os.ReadFile(filepath.Join(dir, "index.d.ts")); err != nil {
+		t.Fatal(err)
+	} else if string(data) != types {
+		t.Errorf("index.d.ts replaced:\n%s", data)
+	}
+
+	tests := []struct {
+		platform, arch, endianness string
+		want                       string
+	}{
+		{"linux", "x64", "LE", "linux/amd64"},
+		{"linux", "ppc64", "LE", "linux/ppc64le"},
+		{"aix", "ppc64", "BE", "aix/ppc64"},
+		{"linux", "ppc64", "BE", `addon: no prebuilt addon to linux-ppc64, available: linux-x64, linux-ppc64le, aix-ppc64`},
+		{"darwin", "arm64", "LE", `addon: no prebuilt addon to darwin-arm64, available: linux-x64, linux-ppc64le, aix-ppc64`},
+	}
+	for _, test := range tests {
+		got := runNode(t, dir, `
+Object.defineProperty(process, "platform", { value: "`+test.platform+`" });
+Object.defineProperty(process, "arch", { value: "`+test.arch+`" });
+require("os").endianness = () => "`+test.endianness+`";
+try { console.log(require("./index.js")) } catch (err) { console.log(err.message) }`)
+		if got != test.want {
+			t.Errorf("%s-%s %s: loader return %q, want %q", test.platform, test.arch, test.endianness, got, test.want)
+		}
+	}
+}
+
+func TestWritePackageJSON(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "package.json")
+	if err := os.WriteFile(file, []byte(`{"name": "custom", "scripts": {"test": "node test.js"}, "main": "old.js"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := buildOptions{Name: "addon", Out: dir, Targets: []target{{"linux", "amd64"}, {"linux", "ppc64le"}, {"aix", "ppc64"}, {"darwin", "amd64"}}}
+	if err := writePackageJSON(opts); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"name":    "custom",
+		"version": "0.0.0",
+		"scripts": map[string]any{"test": "node test.js"},
+		"main":    "index.js",
+		"types":   "index.d.ts",
+		"os":      []any{"linux", "aix", "darwin"},
+		"cpu":     []any{"x64", "ppc64"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("package.json = %s", data)
+	}
+}
+
+// Build example addon to host and check output directory
+func TestBuild(t *testing.T) {
+	if testing.Short() {
+		t.Skip("build addon in short mode")
+	}
+	host, err := goEnvTarget("GOOS", "GOARCH")
+	if err != nil {
+		t.Skip(err)
+	} else if _, _, err := host.node(); err != nil {
+		t.Skip(err)
+	}
+
+	dir := t.TempDir()
+	opts := buildOptions{Package: "../../internal/examples/js_conversion", Out: dir, Targets: []target{host}}
+	if err := build(opts); err != nil {
+		t.Fatal(err)
+	}
+
+	key := host.nodeKey()
+	for _, file := range []string{"index.js", "index.d.ts", "package.json", filepath.Join("prebuilds", key, "js_conversion.node")} {
+		if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+			t.Error(err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "prebuilds", key, "js_conversion.h")); err == nil {
+		t.Error("C header of c-shared not removed")
+	}
+	if data, err := os.ReadFile(filepath.Join(dir, "index.d.ts")); err != nil {
+		t.Fatal(err)
+	} else if !strings.HasPrefix(string(data), generatedTypes) {
+		t.Errorf("index.d.ts not generated by napi-go:\n%s", data)
+	}
+
+	if got := runNode(t, dir, `console.log(typeof require("./index.js"))`); got != "object" {
+		t.Errorf("require addon = %q, want object", got)
+	}
+}
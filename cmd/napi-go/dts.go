@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/dts"
+)
+
+const dtsUsage = `usage: napi-go dts [flags] [package]
+
+Generate TypeScript declarations to values exported by package with (*napi.Object).Set.
+
+Flags:
+`
+
+func runDts(args []string) error {
+	flags := flag.NewFlagSet("dts", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), dtsUsage)
+		flags.PrintDefaults()
+	}
+	output := flags.String("o", "", "output file, default is stdout")
+	flags.Parse(args)
+
+	pkg := "."
+	switch flags.NArg() {
+	case 0:
+	case 1:
+		pkg = flags.Arg(0)
+	default:
+		return fmt.Errorf("only one package can be declared")
+	}
+
+	data, err := generateTypes(pkg)
+	if err != nil {
+		return err
+	} else if *output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(*output, data, 0644)
+}
+
+// Generate TypeScript declarations to package
+func generateTypes(pkg string) ([]byte, error) {
+	gen := dts.New()
+	if err := gen.Load(".", pkg); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	_, err := gen.WriteTo(&buf)
+	return buf.Bytes(), err
+}
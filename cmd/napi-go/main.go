@@ -0,0 +1,63 @@
+// napi-go is the command line tool to build Node.js addons with napi-go.
+//
+// Usage:
+//
+//	napi-go <command> [arguments]
+//
+// The commands are:
+//
+//	build  build addon to .node file, package.json and Javascript loader
+//	dts    generate TypeScript declarations to exported values
+//	bind   generate callbacks and struct conversion without reflection
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+type command struct {
+	Name  string
+	Short string
+	Run   func(args []string) error
+}
+
+var commands = []command{
+	{Name: "build", Short: "build addon to .node file, package.json and Javascript loader", Run: runBuild},
+	{Name: "dts", Short: "generate TypeScript declarations to exported values", Run: runDts},
+	{Name: "bind", Short: "generate callbacks and struct conversion without reflection", Run: runBind},
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "napi-go is a tool to build Node.js addons writed in Go.\n\nUsage:\n\n\tnapi-go <command> [arguments]\n\nThe commands are:\n\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "\t%-8s %s\n", cmd.Name, cmd.Short)
+	}
+	fmt.Fprintf(os.Stderr, "\nUse \"napi-go <command> -h\" for more information about a command.\n")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	if name == "help" || name == "-h" || name == "--help" {
+		usage()
+		return
+	}
+
+	for _, cmd := range commands {
+		if cmd.Name == name {
+			if err := cmd.Run(os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "napi-go %s: %s\n", name, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "napi-go %s: unknown command\nRun 'napi-go help' for usage.\n", name)
+	os.Exit(2)
+}
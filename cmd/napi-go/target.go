@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Go GOOS/GOARCH to build addon
+type target struct {
+	GOOS, GOARCH string
+}
+
+// Go GOOS to Node.js process.platform
+var nodePlatform = map[string]string{
+	"aix":     "aix",
+	"android": "android",
+	"darwin":  "darwin",
+	"freebsd": "freebsd",
+	"linux":   "linux",
+	"netbsd":  "netbsd",
+	"openbsd": "openbsd",
+	"solaris": "sunos",
+}
+
+// Go GOARCH to Node.js process.arch, process.arch of ppc64le is ppc64 and loader check endianness
+var nodeArch = map[string]string{
+	"386":      "ia32",
+	"amd64":    "x64",
+	"arm":      "arm",
+	"arm64":    "arm64",
+	"loong64":  "loong64",
+	"mips":     "mips",
+	"mipsle":   "mipsel",
+	"ppc64":    "ppc64",
+	"ppc64le":  "ppc64le",
+	"riscv64":  "riscv64",
+	"s390x":    "s390x",
+	"mips64le": "mips64el",
+}
+
+// Go GOOS to zig target os and abi
+var zigOS = map[string]string{
+	"linux":   "linux-gnu",
+	"darwin":  "macos",
+	"freebsd": "freebsd",
+}
+
+// Go GOARCH to zig target arch
+var zigArch = map[string]string{
+	"386":     "x86",
+	"amd64":   "x86_64",
+	"arm":     "arm",
+	"arm64":   "aarch64",
+	"loong64": "loongarch64",
+	"ppc64le": "powerpc64le",
+	"riscv64": "riscv64",
+	"s390x":   "s390x",
+}
+
+// Parse list of targets, example "linux/amd64,darwin/arm64", empty string return target of go env GOOS and GOARCH
+func parseTargets(list string) ([]target, error) {
+	if list == "" {
+		t, err := goEnvTarget("GOOS", "GOARCH")
+		if err != nil {
+			return nil, err
+		} else if _, _, err := t.node(); err != nil {
+			return nil, err
+		}
+		return []target{t}, nil
+	}
+
+	var targets []target
+	for value := range strings.SplitSeq(list, ",") {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		goos, goarch, ok := strings.Cut(value, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid target %q, expected GOOS/GOARCH", value)
+		}
+		t := target{goos, goarch}
+		if _, _, err := t.node(); err != nil {
+			return nil, err
+		}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+func (t target) String() string { return t.GOOS + "/" + t.GOARCH }
+
+// Return process.platform and process.arch of target
+func (t target) node() (platform, arch string, err error) {
+	var ok bool
+	if t.GOOS == "windows" {
+		return "", "", fmt.Errorf("%s: windows addons must link with node.lib import library, not supported by napi-go build", t)
+	} else if platform, ok = nodePlatform[t.GOOS]; !ok {
+		return "", "", fmt.Errorf("%s: GOOS not supported by Node.js", t)
+	}
+	if arch, ok = nodeArch[t.GOARCH]; !ok {
+		return "", "", fmt.Errorf("%s: GOARCH not supported by Node.js", t)
+	}
+	return
+}
+
+// Return target in "<process.platform>-<process.arch>" format, example "linux-x64", ppc64le is "linux-ppc64le"
+func (t target) nodeKey() string {
+	platform, arch, _ := t.node()
+	return platform + "-" + arch
+}
+
+// Return "zig cc -target <triple>" to cross compile to target
+func (t target) zigCC() (string, error) {
+	os, ok := zigOS[t.GOOS]
+	if !ok {
+		return "", fmt.Errorf("%s: GOOS not supported by zig", t)
+	}
+	arch, ok := zigArch[t.GOARCH]
+	if !ok {
+		return "", fmt.Errorf("%s: GOARCH not supported by zig", t)
+	}
+	if t.GOARCH == "arm" && t.GOOS == "linux" {
+		os = "linux-gnueabihf"
+	}
+	return fmt.Sprintf("zig cc -target %s-%s", arch, os), nil
+}
+
+// Return target from go env, example goEnvTarget("GOHOSTOS", "GOHOSTARCH")
+func goEnvTarget(goos, goarch string) (target, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "env", goos, goarch)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return target{}, fmt.Errorf("go env %s %s: %s", goos, goarch, bytes.TrimSpace(stderr.Bytes()))
+	}
+	values := strings.Fields(stdout.String())
+	if len(values) != 2 {
+		return target{}, fmt.Errorf("go env %s %s: unexpected output %q", goos, goarch, stdout.String())
+	}
+	return target{values[0], values[1]}, nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTargets(t *testing.T) {
+	tests := []struct {
+		list string
+		want []target
+	}{
+		{"linux/amd64", []target{{"linux", "amd64"}}},
+		{"linux/amd64, darwin/arm64,", []target{{"linux", "amd64"}, {"darwin", "arm64"}}},
+		{"linux/ppc64le,aix/ppc64", []target{{"linux", "ppc64le"}, {"aix", "ppc64"}}},
+	}
+	for _, test := range tests {
+		got, err := parseTargets(test.list)
+		if err != nil {
+			t.Errorf("parseTargets(%q): %s", test.list, err)
+		} else if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("parseTargets(%q) = %v, want %v", test.list, got, test.want)
+		}
+	}
+}
+
+func TestParseTargetsError(t *testing.T) {
+	tests := []struct {
+		list, err string
+	}{
+		{"linux", `invalid target "linux", expected GOOS/GOARCH`},
+		{"plan9/amd64", "plan9/amd64: GOOS not supported by Node.js"},
+		{"linux/wasm", "linux/wasm: GOARCH not supported by Node.js"},
+		{"windows/amd64", "windows/amd64: windows addons must link with node.lib"},
+		{"linux/amd64,windows/arm64", "windows/arm64: windows addons"},
+	}
+	for _, test := range tests {
+		if _, err := parseTargets(test.list); err == nil || !strings.HasPrefix(err.Error(), test.err) {
+			t.Errorf("parseTargets(%q) error = %v, want %q", test.list, err, test.err)
+		}
+	}
+}
+
+func TestParseTargetsHost(t *testing.T) {
+	host, err := goEnvTarget("GOOS", "GOARCH")
+	if err != nil {
+		t.Skip(err)
+	} else if host.GOOS == "windows" {
+		t.Skip("windows host not supported")
+	}
+	got, err := parseTargets("")
+	if err != nil {
+		t.Fatal(err)
+	} else if len(got) != 1 || got[0] != host {
+		t.Errorf("parseTargets(\"\") = %v, want %v", got, host)
+	}
+}
+
+func TestNodeKey(t *testing.T) {
+	tests := []struct {
+		target target
+		want   string
+	}{
+		{target{"linux", "amd64"}, "linux-x64"},
+		{target{"linux", "386"}, "linux-ia32"},
+		{target{"darwin", "arm64"}, "darwin-arm64"},
+		{target{"solaris", "amd64"}, "sunos-x64"},
+		{target{"linux", "mipsle"}, "linux-mipsel"},
+		{target{"linux", "ppc64le"}, "linux-ppc64le"},
+		{target{"aix", "ppc64"}, "aix-ppc64"},
+	}
+	keys := map[string]target{}
+	for _, test := range tests {
+		got := test.target.nodeKey()
+		if got != test.want {
+			t.Errorf("%s: nodeKey() = %q, want %q", test.target, got, test.want)
+		}
+		if other, ok := keys[got]; ok {
+			t.Errorf("%s and %s have same key %q", test.target, other, got)
+		}
+		keys[got] = test.target
+	}
+}
+
+func TestZigCC(t *testing.T) {
+	tests := []struct {
+		target target
+		want   string
+	}{
+		{target{"linux", "amd64"}, "zig cc -target x86_64-linux-gnu"},
+		{target{"linux", "arm"}, "zig cc -target arm-linux-gnueabihf"},
+		{target{"darwin", "arm64"}, "zig cc -target aarch64-macos"},
+		{target{"linux", "ppc64le"}, "zig cc -target powerpc64le-linux-gnu"},
+	}
+	for _, test := range tests {
+		if got, err := test.target.zigCC(); err != nil {
+			t.Errorf("%s: %s", test.target, err)
+		} else if got != test.want {
+			t.Errorf("%s: zigCC() = %q, want %q", test.target, got, test.want)
+		}
+	}
+	for _, target := range []target{{"windows", "amd64"}, {"aix", "ppc64"}} {
+		if _, err := target.zigCC(); err == nil {
+			t.Errorf("%s: zigCC not return error", target)
+		}
+	}
+}
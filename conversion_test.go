@@ -0,0 +1,175 @@
+package napi_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+type level int8
+
+// Color decoded from "#rrggbb" with UnmarshalText of pointer receiver
+type color struct{ R, G, B uint8 }
+
+func (c *color) UnmarshalText(text []byte) error {
+	if len(text) != 4 || text[0] != '#' {
+		return errors.New("invalid color")
+	}
+	for index, ptr := range []*uint8{&c.R, &c.G, &c.B} {
+		n := strings.IndexByte("0123456789abcdef", text[1+index])
+		if n < 0 {
+			return errors.New("invalid color")
+		}
+		*ptr = uint8(n * 17)
+	}
+	return nil
+}
+
+// Point convert itself to [x, y] array with Marshaler and Unmarshaler
+type point struct{ X, Y int }
+
+func (p point) MarshalNapi(env napi.EnvType) (napi.ValueType, error) {
+	return napi.ValueOf(env, []int{p.X, p.Y})
+}
+
+func (p *point) UnmarshalNapi(value napi.ValueType) error {
+	var xy []int
+	if err := napi.ValueFrom(value, &xy); err != nil {
+		return err
+	} else if len(xy) != 2 {
+		return errors.New("point require [x, y]")
+	}
+	p.X, p.Y = xy[0], xy[1]
+	return nil
+}
+
+func TestValueOfNumbers(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{"uint", uint(7), `7`},
+		{"uint8", uint8(255), `255`},
+		{"uint16", uint16(65535), `65535`},
+		{"uint32", uint32(math.MaxUint32), `4294967295`},
+		{"float32", float32(1.5), `1.5`},
+		{"uint64", uint64(1) << 63, `"9223372036854775808n"`},
+		{"int64", int64(-1) << 40, `"-1099511627776n"`},
+		{"named int", level(-3), `-3`},
+		{"struct", struct{ A uint8 }{200}, `{"A":200}`},
+		{"marshaler", point{1, 2}, `[1,2]`},
+		{"marshaler field", struct{ P point }{point{3, 4}}, `{"P":[3,4]}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				value, err := napi.ValueOf(env, test.value)
+				if err != nil {
+					t.Fatal(err)
+				} else if got := describe(t, value); got != test.want {
+					t.Errorf("ValueOf(%#v) = %s, want %s", test.value, got, test.want)
+				}
+			})
+		})
+	}
+}
+
+func TestCreateNumberNamed(t *testing.T) {
+	type ratio float32
+	type big uint64
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		check := func(value *napi.Number, err error, want string) {
+			t.Helper()
+			if err != nil {
+				t.Fatal(err)
+			} else if got := describe(t, value); got != want {
+				t.Errorf("CreateNumber = %s, want %s", got, want)
+			}
+		}
+		n, err := napi.CreateNumber(env, level(-3))
+		check(n, err, `-3`)
+		n, err = napi.CreateNumber(env, ratio(0.25))
+		check(n, err, `0.25`)
+		n, err = napi.CreateNumber(env, big(5))
+		check(n, err, `5`)
+		n, err = napi.CreateNumber(env, big(math.MaxUint64)) // Bigger than int64, converted to double
+		check(n, err, `18446744073709552000`)
+	})
+}
+
+func TestValueFromConversions(t *testing.T) {
+	type palette struct {
+		Main  color    `napi:"main"`
+		Other *color   `napi:"other"`
+		List  []int    `napi:"list"`
+		Name  string   `napi:"name"`
+		Point point    `napi:"point"`
+		Next  *palette `napi:"next"`
+	}
+	tests := []struct {
+		name   string
+		source string
+		want   any
+	}{
+		{"date", `new Date(Date.UTC(2025, 0, 2, 3, 4, 5))`, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{"uint8array", `new Uint8Array([1, 2, 3])`, []byte{1, 2, 3}},
+		{"buffer", `Buffer.from([4])`, []byte{4}},
+		{"text unmarshaler", `"#f80"`, color{255, 136, 0}},
+		{"text unmarshaler field", `({main: "#fff", other: "#000"})`, palette{Main: color{255, 255, 255}, Other: &color{}}},
+		{"unmarshaler", `[1, 2]`, point{1, 2}},
+		{"unmarshaler field", `({point: [3, 4]})`, palette{Point: point{3, 4}}},
+		{"nil pointer allocated", `({name: "a", next: {name: "b"}})`, &palette{Name: "a", Next: &palette{Name: "b"}}},
+		{"undefined pointer field", `({name: "a", next: undefined, other: null})`, palette{Name: "a"}},
+		{"field after slice", `({list: [1, 2], name: "after"})`, palette{List: []int{1, 2}, Name: "after"}},
+		{"uint", `42`, uint(42)},
+		{"uint64 bigint", `2n ** 63n`, uint64(1) << 63},
+		{"float32", `1.5`, float32(1.5)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				got := reflect.New(reflect.TypeOf(test.want))
+				if err := napi.ValueFrom(script(t, env, test.source), got.Interface()); err != nil {
+					t.Fatal(err)
+				}
+				if want, ok := test.want.(time.Time); ok {
+					if !got.Elem().Interface().(time.Time).Equal(want) {
+						t.Errorf("ValueFrom(%s) = %s, want %s", test.source, got.Elem().Interface(), want)
+					}
+				} else if !reflect.DeepEqual(got.Elem().Interface(), test.want) {
+					t.Errorf("ValueFrom(%s) = %#v, want %#v", test.source, got.Elem().Interface(), test.want)
+				}
+			})
+		})
+	}
+}
+
+func TestValueFromConversionsError(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		target any
+		err    string
+	}{
+		{"text unmarshaler", `"red"`, new(color), "invalid color"},
+		{"unmarshaler", `[1]`, new(point), "point require [x, y]"},
+		{"date to int", `new Date()`, new(int), "cannot set"},
+		{"uint16array to bytes", `new Uint16Array([1])`, new([]int), "cannot set"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				if err := napi.ValueFrom(script(t, env, test.source), test.target); err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Errorf("ValueFrom(%s) error = %v, want %q", test.source, err, test.err)
+				}
+			})
+		})
+	}
+}
@@ -17,7 +17,7 @@ func ToDataView(o ValueType) *DataView { return &DataView{o} }
 // CreateDataView creates a new JavaScript DataView instance over an existing ArrayBuffer.
 func CreateDataView(env EnvType, buffer *ArrayBuffer, byteOffset, byteLength int) (*DataView, error) {
 	napiValue, status := napi.CreateDataView(env.NapiValue(), byteLength, buffer.NapiValue(), byteOffset)
-	if err := status.ToError(); err != nil {
+	if err := statusError(env.NapiValue(), "napi_create_dataview", status); err != nil {
 		return nil, err
 	}
 	return ToDataView(N_APIValue(env, napiValue)), nil
@@ -33,7 +33,7 @@ func (dv *DataView) Info() (buffer *ArrayBuffer, byteLength int, byteOffset int,
 	var dataRawPtr *byte // Pointer to the start of the *ArrayBuffer*'s data
 
 	lengthC, dataRawPtr, napiBuffer, offsetC, status := napi.GetDataViewInfo(dv.NapiEnv(), dv.NapiValue())
-	if err = status.ToError(); err != nil {
+	if err = statusError(dv.NapiEnv(), "napi_get_dataview_info", status); err != nil {
 		return
 	}
 
@@ -15,8 +15,8 @@ func ToDate(o ValueType) *Date { return &Date{o} }
 // using the provided Go time.Time value. It returns a pointer to a Date wrapper
 // or an error if the creation fails.
 func CreateDate(env EnvType, t time.Time) (*Date, error) {
-	value, err := mustValueErr(napi.CreateDate(env.NapiValue(), float64(t.UnixMilli())))
-	if err != nil {
+	value, status := napi.CreateDate(env.NapiValue(), float64(t.UnixMilli()))
+	if err := statusError(env.NapiValue(), "napi_create_date", status); err != nil {
 		return nil, err
 	}
 	return &Date{value: &_Value{env: env, valueOf: value}}, nil
@@ -27,8 +27,8 @@ func CreateDate(env EnvType, t time.Time) (*Date, error) {
 // converts it to a Unix millisecond timestamp, and constructs a time.Time object.
 // If an error occurs during value retrieval or conversion, it is returned.
 func (d Date) Time() (time.Time, error) {
-	timeFloat, err := mustValueErr(napi.GetDateValue(d.NapiEnv(), d.NapiValue()))
-	if err != nil {
+	timeFloat, status := napi.GetDateValue(d.NapiEnv(), d.NapiValue())
+	if err := statusError(d.NapiEnv(), "napi_get_date_value", status); err != nil {
 		return time.Time{}, err
 	}
 	return time.UnixMilli(int64(timeFloat)), nil
@@ -0,0 +1,122 @@
+package napi
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrSettled is returned by [Deferred.Resolve] and [Deferred.Reject] if promise already resolved or rejected
+var ErrSettled = errors.New("napi: deferred already settled")
+
+// Deferred is promise that can be settled from any goroutine, values are converted with [ValueOf]
+// in Javascript thread. Deferred keep Node.js event loop alive until promise is settled.
+type Deferred[T any] struct {
+	promise  *Promise
+	tsfn     *ThreadsafeFunction
+	mu       sync.Mutex // Hold while queue settle, others Resolve and Reject wait result
+	settled  bool
+	onSettle func(env EnvType) // Called in Javascript thread before settle
+}
+
+// NewDeferred create promise to be settled from any goroutine, call in Javascript thread.
+//
+//	napi.ExportFunc("read", napi.Callback(func(ci *napi.CallbackInfo) (napi.ValueType, error) {
+//		deferred, err := napi.NewDeferred[[]byte](ci.Env)
+//		if err != nil {
+//			return nil, err
+//		}
+//		go func() {
+//			data, err := os.ReadFile("file.txt")
+//			if err != nil {
+//				deferred.Reject(err)
+//				return
+//			}
+//			deferred.Resolve(data)
+//		}()
+//		return deferred.Promise(), nil
+//	}))
+func NewDeferred[T any](env EnvType) (*Deferred[T], error) {
+	promise, err := CreatePromise(env)
+	if err != nil {
+		return nil, err
+	}
+	tsfn, err := createThreadsafeFunction(env, nil, nil, func(env EnvType, _ *Function, data any) {
+		data.(func(EnvType))(env)
+	}, "napi-go/deferred", 0, 1, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Deferred[T]{promise: promise, tsfn: tsfn}, nil
+}
+
+// Promise return Javascript promise of Deferred
+func (deferred *Deferred[T]) Promise() *Promise { return deferred.promise }
+
+// Resolve promise with value, if value cannot be converted to Javascript promise is rejected with error.
+// Safe to call from any goroutine, return [ErrSettled] if already settled.
+func (deferred *Deferred[T]) Resolve(value T) error {
+	return deferred.settle(func(env EnvType) error {
+		jsValue, err := ValueOf(env, value)
+		if err != nil {
+			return deferred.reject(env, err)
+		} else if jsValue == nil {
+			if jsValue, err = env.Undefined(); err != nil {
+				return err
+			}
+		}
+		return deferred.promise.Resolve(jsValue)
+	})
+}
+
+// Reject promise with err, [*Exception] reject with Javascript value thrown and others errors with Error.
+// Safe to call from any goroutine, return [ErrSettled] if already settled.
+func (deferred *Deferred[T]) Reject(err error) error {
+	return deferred.settle(func(env EnvType) error { return deferred.reject(env, err) })
+}
+
+func (deferred *Deferred[T]) reject(env EnvType, err error) error {
+	jsErr, err := errorValue(env, err)
+	if err != nil {
+		return err
+	}
+	return deferred.promise.Reject(jsErr)
+}
+
+// Queue settle in Javascript thread and release threadsafe function to not keep event loop alive,
+// if cannot queue return error and deferred is not settled.
+func (deferred *Deferred[T]) settle(fn func(env EnvType) error) error {
+	deferred.mu.Lock()
+	defer deferred.mu.Unlock()
+	if deferred.settled {
+		return ErrSettled
+	}
+	err := deferred.tsfn.Call(func(env EnvType) {
+		if deferred.onSettle != nil {
+			deferred.onSettle(env)
+		}
+		if err := fn(env); err != nil && !errors.Is(err, ErrPendingException) {
+			ThrowError(env, "", err.Error())
+		}
+	}, NonBlocking)
+	if err != nil {
+		return err // Not queued, promise still pending
+	}
+	deferred.settled = true
+	return deferred.tsfn.Release(Release)
+}
+
+// Settle in Javascript thread without queue, to settle with Javascript values of current scope
+func (deferred *Deferred[T]) settleNow(env EnvType, fn func(env EnvType) error) error {
+	deferred.mu.Lock()
+	if deferred.settled {
+		deferred.mu.Unlock()
+		return ErrSettled
+	}
+	deferred.settled = true
+	deferred.mu.Unlock()
+	defer deferred.tsfn.Release(Release)
+	if deferred.onSettle != nil {
+		deferred.onSettle(env)
+	}
+	return fn(env)
+}
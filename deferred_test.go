@@ -0,0 +1,133 @@
+package napi_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+// Settled state of promise, value is JSON of resolved value or message of rejected error
+type settled struct{ State, Value string }
+
+// Send settled state of promise to channel when promise settle
+func await(t *napitest.T, env napi.EnvType, promise napi.ValueType, ch chan<- settled) {
+	t.Helper()
+	then, err := napi.CompileFunction(env, `(promise, done) => promise.then(
+	value => done("resolved", JSON.stringify(value) ?? "undefined"),
+	err => done("rejected", err instanceof Error ? err.message : String(err)))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done, err := napi.GoFuncOf(env, func(state, value string) { ch <- settled{state, value} })
+	if err != nil {
+		t.Fatal(err)
+	} else if _, err = then.Call(promise, done); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Marshaler always failing, to reject promise with conversion error
+type failMarshal struct{}
+
+func (failMarshal) MarshalNapi(napi.EnvType) (napi.ValueType, error) {
+	return nil, errors.New("cannot marshal")
+}
+
+func TestDeferred(t *testing.T) {
+	type result struct {
+		Name  string `napi:"name"`
+		Count int    `napi:"count"`
+	}
+	tests := []struct {
+		name   string
+		settle func(deferred *napi.Deferred[any]) error
+		want   settled
+	}{
+		{"resolve", func(deferred *napi.Deferred[any]) error {
+			return deferred.Resolve(result{"go", 2})
+		}, settled{"resolved", `{"name":"go","count":2}`}},
+		{"resolve nil", func(deferred *napi.Deferred[any]) error { return deferred.Resolve(nil) }, settled{"resolved", "undefined"}},
+		{"resolve not convertible", func(deferred *napi.Deferred[any]) error {
+			return deferred.Resolve(failMarshal{})
+		}, settled{"rejected", "cannot marshal"}},
+		{"reject", func(deferred *napi.Deferred[any]) error {
+			return deferred.Reject(errors.New("failed"))
+		}, settled{"rejected", "failed"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := make(chan settled, 1)
+			errs := make(chan error, 1)
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				deferred, err := napi.NewDeferred[any](env)
+				if err != nil {
+					t.Fatal(err)
+				}
+				await(t, env, deferred.Promise(), results)
+				go func() { errs <- test.settle(deferred) }()
+			})
+			if err := wait(t, errs); err != nil {
+				t.Fatal(err)
+			}
+			if got := wait(t, results); got != test.want {
+				t.Errorf("promise %s %s, want %s %s", got.State, got.Value, test.want.State, test.want.Value)
+			}
+		})
+	}
+}
+
+func TestDeferredSettled(t *testing.T) {
+	results := make(chan settled, 1)
+	var deferred *napi.Deferred[int]
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		var err error
+		if deferred, err = napi.NewDeferred[int](env); err != nil {
+			t.Fatal(err)
+		}
+		await(t, env, deferred.Promise(), results)
+	})
+
+	// Only one of concurrent Resolve and Reject settle promise
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for n := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if n%2 == 0 {
+				errs <- deferred.Resolve(n)
+			} else {
+				errs <- deferred.Reject(errors.New("rejected"))
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	var ok int
+	for err := range errs {
+		if err == nil {
+			ok++
+		} else if !errors.Is(err, napi.ErrSettled) {
+			t.Errorf("settle error = %v, want ErrSettled", err)
+		}
+	}
+	if ok != 1 {
+		t.Errorf("%d calls settled promise, want 1", ok)
+	}
+	wait(t, results)
+
+	if err := deferred.Resolve(1); !errors.Is(err, napi.ErrSettled) {
+		t.Errorf("Resolve after settled = %v, want ErrSettled", err)
+	}
+	if err := deferred.Reject(errors.New("again")); !errors.Is(err, napi.ErrSettled) {
+		t.Errorf("Reject after settled = %v, want ErrSettled", err)
+	}
+	select {
+	case got := <-results:
+		t.Errorf("promise settled again: %v", got)
+	default:
+	}
+}
@@ -0,0 +1,131 @@
+package napi
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// Dispatcher of env, threadsafe function created on module init to run Go functions in Javascript thread
+type dispatcher struct {
+	tsfn   *ThreadsafeFunction
+	thread uintptr       // Javascript thread of env
+	done   chan struct{} // Closed when threadsafe function is finalized
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+var dispatchers sync.Map // napi.Env to *dispatcher
+
+// Create dispatcher of env, called in Javascript thread by initModule
+func startDispatcher(env EnvType) error {
+	if _, ok := dispatchers.Load(env.NapiValue()); ok {
+		return nil
+	}
+
+	d := &dispatcher{thread: napi.CurrentThread(), done: make(chan struct{})}
+	tsfn, err := createThreadsafeFunction(env, nil, func(EnvType, any) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		d.closed = true
+		close(d.done)
+		dispatchers.CompareAndDelete(env.NapiValue(), d)
+	}, func(env EnvType, _ *Function, data any) {
+		data.(func(EnvType))(env)
+	}, "napi-go/dispatcher", 0, 1, nil)
+	if err != nil {
+		return err
+	} else if err = tsfn.Unref(env); err != nil { // Not keep Node.js running
+		return err
+	}
+	d.tsfn = tsfn
+	dispatchers.Store(env.NapiValue(), d)
+	return nil
+}
+
+func dispatcherOf(env EnvType) (*dispatcher, error) {
+	d, ok := dispatchers.Load(env.NapiValue())
+	if !ok {
+		return nil, fmt.Errorf("napi: env without dispatcher, module not initialized with package module or entry, or backend without threadsafe functions")
+	}
+	return d.(*dispatcher), nil
+}
+
+// Return true if current thread is Javascript thread of env
+func isJSThread(env EnvType) bool {
+	d, err := dispatcherOf(env)
+	return err == nil && napi.CurrentThread() == d.thread
+}
+
+// Post queue fn to be called in Javascript thread of env and return without wait,
+// safe to call from any goroutine, including Javascript thread.
+//
+// Errors and panics of fn are uncaught exceptions in Node.js, use [RunOnJS] to get result of function.
+func Post(env EnvType, fn func(env EnvType)) error {
+	d, err := dispatcherOf(env)
+	if err != nil {
+		return err
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		return statusError(nil, "napi_call_threadsafe_function", napi.StatusClosing)
+	}
+	return d.tsfn.Call(fn, NonBlocking)
+}
+
+// RunOnJS call fn in Javascript thread of env and wait for result, safe to call from any goroutine.
+//
+// Called from Javascript thread RunOnJS return [ErrWouldDeadlock], in Javascript thread call fn directly.
+// Panic of fn and Javascript exception pending after fn are returned as error.
+//
+//	go func() {
+//		length, err := napi.RunOnJS(env, func(env napi.EnvType) (int, error) {
+//			return callback.Length()
+//		})
+//	}()
+func RunOnJS[T any](env EnvType, fn func(env EnvType) (T, error)) (value T, err error) {
+	d, err := dispatcherOf(env)
+	if err != nil {
+		return value, err
+	} else if isJSThread(env) {
+		return value, fmt.Errorf("napi: RunOnJS called in Javascript thread: %w", ErrWouldDeadlock)
+	}
+
+	type result struct {
+		value T
+		err   error
+	}
+	results := make(chan result, 1)
+	err = Post(env, func(env EnvType) {
+		var res result
+		defer func() {
+			if v := recover(); v != nil {
+				res.err = fmt.Errorf("napi: RunOnJS panic recover: %v", v)
+			}
+			results <- res
+		}()
+		if res.value, res.err = fn(env); errors.Is(res.err, ErrPendingException) {
+			res.err = lastException(env)
+		}
+	})
+	if err != nil {
+		return value, err
+	}
+
+	select {
+	case res := <-results:
+		return res.value, res.err
+	case <-d.done: // Env exiting, queue dropped
+		select {
+		case res := <-results:
+			return res.value, res.err
+		default:
+			return value, statusError(nil, "napi_call_threadsafe_function", napi.StatusClosing)
+		}
+	}
+}
@@ -0,0 +1,414 @@
+// Package dts generate TypeScript declarations (.d.ts) to values exported by napi-go addons.
+//
+// Go types are converted with same rules of [sirherobrine23.com.br/Sirherobrine23/napi-go.ValueOf]:
+//
+//   - string to string, bool to boolean, int*, uint* and float* to number
+//   - int64 and uint64 to bigint
+//   - []byte to Buffer, other slices and arrays to T[]
+//   - time.Time to Date, encoding.TextMarshaler to string
+//   - map[K]V to Record<string, V>
+//   - struct to interface, fields named by `napi:"name"` tag and optional with omitempty or omitzero
+//   - func to function, last error return is removed and multiple returns are tuple [T1, T2]
+//   - func with context.Context as first argument to function with optional AbortSignal returning Promise
+//   - pointers to T | undefined
+//   - *napi.Promise to Promise
+package dts
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+const napiPackage = "sirherobrine23.com.br/Sirherobrine23/napi-go"
+
+// Tag name to set struct field name, same of napi-go
+const propertiesTagName = "napi"
+
+// Go napi-go types to TypeScript
+var napiTypes = map[string]string{
+	"ValueType":   "any",
+	"String":      "string",
+	"Number":      "number",
+	"Bigint":      "bigint",
+	"Boolean":     "boolean",
+	"Object":      "object",
+	"Array":       "any[]",
+	"Buffer":      "Buffer",
+	"Date":        "Date",
+	"Error":       "Error",
+	"Function":    "(...args: any[]) => any",
+	"Callback":    "(...args: any[]) => any",
+	"Promise":     "Promise<any>",
+	"ArrayBuffer": "ArrayBuffer",
+	"TypedArray":  "ArrayBufferView",
+	"DataView":    "DataView",
+	"External":    "unknown",
+}
+
+// Generator collect exported values and write TypeScript declarations
+type Generator struct {
+	exports    []export
+	interfaces []*iface
+	named      map[*types.Named]*iface
+}
+
+type export struct {
+	Name string
+	Type types.Type
+	TS   string
+}
+
+type iface struct {
+	Name   string
+	Fields []field
+}
+
+type field struct {
+	Name, TS string
+	Optional bool
+}
+
+// New return empty generator
+func New() *Generator {
+	return &Generator{named: map[*types.Named]*iface{}}
+}
+
+// Export declare Javascript export name with Go type, functions are declared as function
+// and others types as const.
+func (g *Generator) Export(name string, typ types.Type) {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem() // exported value is not nil, example: napi.ValueOf(env, &Struct{})
+	}
+	g.exports = append(g.exports, export{Name: name, Type: typ, TS: g.TypeOf(typ)})
+}
+
+// Export declare Javascript export name with TypeScript type
+func (g *Generator) ExportTS(name, ts string) {
+	g.exports = append(g.exports, export{Name: name, TS: ts})
+}
+
+// TypeOf return TypeScript type to Go type, struct named types are declared as interface.
+func (g *Generator) TypeOf(typ types.Type) string {
+	if named, ok := typ.(*types.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil {
+			switch path := obj.Pkg().Path(); {
+			case path == napiPackage:
+				if ts, ok := napiTypes[obj.Name()]; ok {
+					return ts
+				} else if obj.Name() == "Optional" && named.TypeArgs().Len() == 1 {
+					return g.TypeOf(named.TypeArgs().At(0)) + " | undefined"
+				}
+			case path == "time" && obj.Name() == "Time":
+				return "Date"
+			}
+		}
+		if implements(typ, "MarshalText") {
+			return "string"
+		} else if implements(typ, "MarshalJSON") {
+			return "any"
+		}
+	}
+
+	switch typ := typ.(type) {
+	case *types.Alias:
+		return g.TypeOf(types.Unalias(typ))
+	case *types.Basic:
+		switch {
+		case typ.Kind() == types.Int64 || typ.Kind() == types.Uint64:
+			return "bigint"
+		case typ.Info()&types.IsNumeric != 0:
+			return "number"
+		case typ.Info()&types.IsString != 0:
+			return "string"
+		case typ.Info()&types.IsBoolean != 0:
+			return "boolean"
+		case typ.Kind() == types.UntypedNil:
+			return "null"
+		}
+		return "undefined"
+	case *types.Pointer:
+		if named, ok := typ.Elem().(*types.Named); ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == napiPackage {
+			return g.TypeOf(named) // *napi.String, *napi.Object, etc
+		}
+		return g.TypeOf(typ.Elem()) + " | undefined" // nil pointer is undefined
+	case *types.Slice:
+		if basic, ok := typ.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Uint8 {
+			return "Buffer"
+		}
+		return arrayOf(g.TypeOf(typ.Elem()))
+	case *types.Array:
+		return arrayOf(g.TypeOf(typ.Elem()))
+	case *types.Map:
+		return fmt.Sprintf("Record<string, %s>", g.TypeOf(typ.Elem()))
+	case *types.Signature:
+		return g.signature(typ)
+	case *types.Interface:
+		return "any"
+	case *types.Struct:
+		var buf strings.Builder
+		buf.WriteString("{ ")
+		for _, field := range g.fields(typ) {
+			fmt.Fprintf(&buf, "%s%s: %s; ", propertyName(field.Name), optional(field.Optional), field.TS)
+		}
+		buf.WriteString("}")
+		return buf.String()
+	case *types.Named:
+		st, ok := typ.Underlying().(*types.Struct)
+		if !ok {
+			return g.TypeOf(typ.Underlying())
+		}
+		if decl, ok := g.named[typ]; ok {
+			return decl.Name
+		}
+		decl := &iface{Name: g.interfaceName(typ)}
+		g.named[typ] = decl
+		g.interfaces = append(g.interfaces, decl)
+		decl.Fields = g.fields(st)
+		return decl.Name
+	case *types.TypeParam, *types.Chan:
+		return "any"
+	}
+	return "any"
+}
+
+// Return TypeScript function type
+func (g *Generator) signature(sig *types.Signature) string {
+	params, result := g.signatureParts(sig, false)
+	return fmt.Sprintf("(%s) => %s", params, result)
+}
+
+// Return parameters and return type of function, omitted is true to mark all parameters optional,
+// same of function exported with napi.GoFuncOf, overloads are selected by count of arguments
+func (g *Generator) signatureParts(sig *types.Signature, omitted bool) (params, result string) {
+	if isCallback(sig) {
+		return "...args: any[]", "any"
+	}
+
+	// context.Context as first argument is async function with AbortSignal
+	first, async := 0, sig.Params().Len() > 0 && isContext(sig.Params().At(0).Type())
+	if async || (sig.Params().Len() > 0 && isNapiType(sig.Params().At(0).Type(), "CallbackInfo", "This")) {
+		first = 1 // Not Javascript argument
+	}
+
+	// Trailing optional parameters can be omitted
+	optionalFrom := sig.Params().Len()
+	if sig.Variadic() {
+		optionalFrom--
+	}
+	for optionalFrom > first && (omitted || isOptional(sig.Params().At(optionalFrom-1).Type())) {
+		optionalFrom--
+	}
+
+	var args []string
+	for index := first; index < sig.Params().Len(); index++ {
+		param := sig.Params().At(index)
+		name := param.Name()
+		if name == "" || name == "_" {
+			name = fmt.Sprintf("arg%d", index)
+		}
+		if sig.Variadic() && index == sig.Params().Len()-1 {
+			args = append(args, fmt.Sprintf("...%s: %s", name, g.TypeOf(param.Type())))
+			continue
+		}
+		args = append(args, fmt.Sprintf("%s%s: %s", name, optional(index >= optionalFrom), g.TypeOf(param.Type())))
+	}
+
+	var results []string
+	for index := range sig.Results().Len() {
+		typ := sig.Results().At(index).Type()
+		if index == sig.Results().Len()-1 && types.Identical(typ, types.Universe.Lookup("error").Type()) {
+			break
+		}
+		results = append(results, g.TypeOf(typ))
+	}
+
+	switch len(results) {
+	case 0:
+		result = "void"
+	case 1:
+		result = results[0]
+	default:
+		result = "[" + strings.Join(results, ", ") + "]"
+	}
+	if async {
+		if !sig.Variadic() {
+			args = append(args, "signal?: AbortSignal")
+		}
+		result = "Promise<" + result + ">"
+	}
+	return strings.Join(args, ", "), result
+}
+
+// Return true if parameter accept undefined and can be omitted in overloads: pointer, interface or napi.Optional
+func isOptional(typ types.Type) bool {
+	switch typ.Underlying().(type) {
+	case *types.Pointer, *types.Interface:
+		return true
+	}
+	return isNapiType(typ, "Optional")
+}
+
+// Return true if typ or element of pointer is napi-go type with one of names
+func isNapiType(typ types.Type, names ...string) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == napiPackage && slices.Contains(names, named.Obj().Name())
+}
+
+func isContext(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "context" && named.Obj().Name() == "Context"
+}
+
+// Return struct fields with same rules of napi.ValueOf
+func (g *Generator) fields(st *types.Struct) (fields []field) {
+	for index := range st.NumFields() {
+		goField := st.Field(index)
+		tag := strings.TrimSpace(reflect.StructTag(st.Tag(index)).Get(propertiesTagName))
+		if !goField.Exported() || tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = goField.Name()
+		}
+		fields = append(fields, field{
+			Name:     name,
+			TS:       g.TypeOf(goField.Type()),
+			Optional: opts == "omitempty" || opts == "omitzero" || isNapiType(goField.Type(), "Optional"),
+		})
+	}
+	return
+}
+
+// Return unique interface name to named type
+func (g *Generator) interfaceName(named *types.Named) string {
+	name := named.Obj().Name()
+	if named.Obj().Pkg() != nil && slices.ContainsFunc(g.interfaces, func(decl *iface) bool { return decl.Name == name }) {
+		name = named.Obj().Pkg().Name() + name
+	}
+	for base, count := name, 2; slices.ContainsFunc(g.interfaces, func(decl *iface) bool { return decl.Name == name }); count++ {
+		name = fmt.Sprintf("%s%d", base, count)
+	}
+	return name
+}
+
+// WriteTo write TypeScript declarations to w
+func (g *Generator) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by napi-go dts. DO NOT EDIT.\n")
+
+	for _, decl := range g.interfaces {
+		fmt.Fprintf(&buf, "\nexport interface %s {\n", decl.Name)
+		for _, field := range decl.Fields {
+			fmt.Fprintf(&buf, "  %s%s: %s;\n", propertyName(field.Name), optional(field.Optional), field.TS)
+		}
+		buf.WriteString("}\n")
+	}
+
+	if len(g.exports) > 0 {
+		buf.WriteString("\n")
+	}
+	overloads := map[string]int{}
+	for _, exp := range g.exports {
+		overloads[exp.Name]++
+	}
+	for index, exp := range g.exports {
+		if sig, ok := underlyingSignature(exp.Type); ok && isIdentifier(exp.Name) {
+			params, result := g.signatureParts(sig, overloads[exp.Name] == 1)
+			fmt.Fprintf(&buf, "export declare function %s(%s): %s;\n", exp.Name, params, result)
+			continue
+		} else if isIdentifier(exp.Name) {
+			fmt.Fprintf(&buf, "export declare const %s: %s;\n", exp.Name, exp.TS)
+			continue
+		}
+		// Names not valid as identifier, declare by export alias
+		alias := fmt.Sprintf("_export%d", index)
+		fmt.Fprintf(&buf, "declare const %s: %s;\nexport { %s as %q };\n", alias, exp.TS, alias, exp.Name)
+	}
+
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+func arrayOf(ts string) string {
+	if strings.ContainsAny(ts, " |") {
+		return "(" + ts + ")[]"
+	}
+	return ts + "[]"
+}
+
+func optional(ok bool) string {
+	if ok {
+		return "?"
+	}
+	return ""
+}
+
+// Quote property name if not valid identifier
+func propertyName(name string) string {
+	if isIdentifier(name) {
+		return name
+	}
+	return fmt.Sprintf("%q", name)
+}
+
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for index, r := range name {
+		switch {
+		case r == '_' || r == '$', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case index > 0 && '0' <= r && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Check if method is declared to type or pointer to type
+func implements(typ types.Type, method string) bool {
+	for _, typ := range []types.Type{typ, types.NewPointer(typ)} {
+		if obj, _, _ := types.LookupFieldOrMethod(typ, true, nil, method); obj != nil {
+			if _, ok := obj.(*types.Func); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Check if function is napi.Callback signature: func(*napi.CallbackInfo) (napi.ValueType, error)
+func isCallback(sig *types.Signature) bool {
+	if sig.Params().Len() != 1 || sig.Results().Len() != 2 {
+		return false
+	}
+	ptr, ok := sig.Params().At(0).Type().(*types.Pointer)
+	if !ok {
+		return false
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == napiPackage && named.Obj().Name() == "CallbackInfo"
+}
+
+func underlyingSignature(typ types.Type) (*types.Signature, bool) {
+	if typ == nil {
+		return nil, false
+	}
+	if named, ok := types.Unalias(typ).(*types.Named); ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == napiPackage {
+		return nil, false // napi.Callback, napi.Function, etc
+	}
+	sig, ok := typ.Underlying().(*types.Signature)
+	return sig, ok
+}
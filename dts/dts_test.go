@@ -0,0 +1,36 @@
+package dts_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/dts"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+func TestLoadGolden(t *testing.T) {
+	gen := dts.New()
+	if err := gen.Load(".", "./testdata/addon"); err != nil {
+		t.Fatal(err)
+	}
+	var got bytes.Buffer
+	if _, err := gen.WriteTo(&got); err != nil {
+		t.Fatal(err)
+	}
+
+	golden := "testdata/addon.d.ts"
+	if *update {
+		if err := os.WriteFile(golden, got.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(got.Bytes(), want) {
+		t.Errorf("declarations differ from %s, run go test -update:\n%s", golden, got.Bytes())
+	}
+}
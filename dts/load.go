@@ -0,0 +1,195 @@
+package dts
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/pkgload"
+)
+
+// Functions from napi-go to convert Go values, index of argument with Go value
+var valueFuncs = map[string]int{
+	"GoFuncOf":       1,
+	"ValueOf":        1,
+	"CreateFunction": 2,
+}
+
+// Load type check Go package (import path or directory relative to dir) and
+// add values exported with (*napi.Object).Set, napi.Export, napi.ExportFunc and napi.ExportOverload to generator.
+//
+// Values converted with napi.GoFuncOf, napi.ValueOf and napi.CreateFunction have type of Go value,
+// others values have type of napi-go type, example *napi.String to string.
+func (g *Generator) Load(dir, pattern string) error {
+	pkg, err := pkgload.Load(dir, pattern, false)
+	if err != nil {
+		return err
+	}
+	g.inspect(pkg.Files, pkg.Info)
+	return nil
+}
+
+// Walk files to find exported values
+func (g *Generator) inspect(files []*ast.File, info *types.Info) {
+	// Variables with Go value converted by napi-go
+	values := map[types.Object]types.Type{}
+	for _, file := range files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			var lhs []ast.Expr
+			var rhs []ast.Expr
+			switch node := node.(type) {
+			case *ast.AssignStmt:
+				lhs, rhs = node.Lhs, node.Rhs
+			case *ast.ValueSpec:
+				for _, name := range node.Names {
+					lhs = append(lhs, name)
+				}
+				rhs = node.Values
+			default:
+				return true
+			}
+			if len(rhs) != 1 || len(lhs) == 0 {
+				return true
+			}
+			if typ, ok := goValueOf(info, rhs[0]); ok {
+				if ident, ok := lhs[0].(*ast.Ident); ok {
+					if obj := objectOf(info, ident); obj != nil {
+						values[obj] = typ
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	for _, file := range files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			} else if napiFunc(info, call) == "ExportOverload" && len(call.Args) > 1 && !call.Ellipsis.IsValid() {
+				if name, ok := stringConst(info, call.Args[0]); ok {
+					for _, fn := range call.Args[1:] {
+						g.Export(name, overloadType(info.TypeOf(fn)))
+					}
+				}
+				return true
+			} else if len(call.Args) != 2 {
+				return true
+			}
+			if fn := napiFunc(info, call); fn == "Export" || fn == "ExportFunc" {
+				if name, ok := stringConst(info, call.Args[0]); ok {
+					g.Export(name, info.TypeOf(call.Args[1]))
+				}
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Set" || !isNapiObject(info.TypeOf(sel.X)) {
+				return true
+			}
+			name, ok := stringConst(info, call.Args[0])
+			if !ok {
+				return true
+			}
+
+			value := ast.Unparen(call.Args[1])
+			if fns, ok := overloadsOf(info, value); ok {
+				for _, fn := range fns {
+					g.Export(name, overloadType(info.TypeOf(fn)))
+				}
+			} else if typ, ok := goValueOf(info, value); ok {
+				g.Export(name, typ)
+			} else if ident, ok := value.(*ast.Ident); ok && values[objectOf(info, ident)] != nil {
+				g.Export(name, values[objectOf(info, ident)])
+			} else {
+				g.Export(name, info.TypeOf(value))
+			}
+			return true
+		})
+	}
+}
+
+// Return Go type of value converted by napi.GoFuncOf, napi.ValueOf or napi.CreateFunction
+func goValueOf(info *types.Info, expr ast.Expr) (types.Type, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+	index, ok := valueFuncs[napiFunc(info, call)]
+	if !ok || index >= len(call.Args) {
+		return nil, false
+	}
+	return info.TypeOf(call.Args[index]), true
+}
+
+// Return functions of napi.Overload, exported as TypeScript overloads
+func overloadsOf(info *types.Info, expr ast.Expr) ([]ast.Expr, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || napiFunc(info, call) != "Overload" || len(call.Args) < 3 || call.Ellipsis.IsValid() {
+		return nil, false
+	}
+	return call.Args[2:], true
+}
+
+// Return signature of napi.Callback to declare as function with others overloads
+func overloadType(typ types.Type) types.Type {
+	if sig, ok := typ.Underlying().(*types.Signature); ok {
+		return sig
+	}
+	return typ
+}
+
+// Return name of napi-go function called, empty if not is napi-go function
+func napiFunc(info *types.Info, call *ast.CallExpr) string {
+	var ident *ast.Ident
+	switch fn := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = fn
+	case *ast.SelectorExpr:
+		ident = fn.Sel
+	default:
+		return ""
+	}
+
+	fn, ok := info.Uses[ident].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != napiPackage {
+		return ""
+	} else if recv := fn.Signature().Recv(); recv != nil && !isExecutor(recv.Type()) {
+		return ""
+	}
+	return fn.Name()
+}
+
+// (*napi.Executor).ExportFunc and GoFuncOf has same arguments of napi functions
+func isExecutor(typ types.Type) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	return ok && named.Obj().Name() == "Executor"
+}
+
+func objectOf(info *types.Info, ident *ast.Ident) types.Object {
+	if obj := info.Defs[ident]; obj != nil {
+		return obj
+	}
+	return info.Uses[ident]
+}
+
+func stringConst(info *types.Info, expr ast.Expr) (string, bool) {
+	value := info.Types[expr].Value
+	if value == nil || value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(value), true
+}
+
+// Check if type is *napi.Object
+func isNapiObject(typ types.Type) bool {
+	ptr, ok := typ.(*types.Pointer)
+	if !ok {
+		return false
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == napiPackage && named.Obj().Name() == "Object"
+}
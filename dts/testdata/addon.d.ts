@@ -0,0 +1,40 @@
+// Code generated by napi-go dts. DO NOT EDIT.
+
+export interface User {
+  name: string;
+  age?: number;
+  email?: string | undefined;
+  address: Address | undefined;
+  Tags: string[];
+  avatar: Buffer;
+  created: Date;
+  balance: bigint;
+  metadata: Record<string, any>;
+  "content-type": string;
+}
+
+export interface Address {
+  street: string;
+  number?: number;
+}
+
+export declare const version: string;
+export declare const defaultUser: User;
+export declare function getUser(id?: number): User | undefined;
+export declare function save(user?: User): void;
+export declare function optional(name?: string, greeting?: string | undefined, times?: number | undefined): string;
+export declare function optionalBeforeRequired(value?: number | undefined, name?: string): void;
+export declare function sum(first?: number, ...numbers: number[]): number;
+export declare function divide(a?: number, b?: number): [number, number];
+export declare function fetch(url?: string, signal?: AbortSignal): Promise<Buffer>;
+export declare function wait(signal?: AbortSignal): Promise<void>;
+export declare function all(...urls: string[]): Promise<string[]>;
+export declare function method(value?: any): Promise<any>;
+export declare function raw(...args: any[]): any;
+export declare function callback(fn?: (err: any, value: string) => void): void;
+export declare function parse(value: string): number;
+export declare function parse(value: Buffer, base: number): number;
+export declare const message: string;
+declare const _export17: Date;
+export { _export17 as "started-at" };
+export declare function index(users?: User[], limit?: number | undefined): Record<string, User | undefined>;
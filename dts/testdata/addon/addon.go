@@ -0,0 +1,59 @@
+// Addon to golden test of TypeScript declarations, only type checked.
+package main
+
+import (
+	"context"
+	"time"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+)
+
+func main() {}
+
+type Address struct {
+	Street string `napi:"street"`
+	Number int    `napi:"number,omitempty"`
+}
+
+type User struct {
+	Name     string                `napi:"name"`
+	Age      int                   `napi:"age,omitzero"`
+	Email    napi.Optional[string] `napi:"email"`
+	Address  *Address              `napi:"address"`
+	Tags     []string
+	Avatar   []byte         `napi:"avatar"`
+	Created  time.Time      `napi:"created"`
+	Balance  int64          `napi:"balance"`
+	Metadata map[string]any `napi:"metadata"`
+	Kind     string         `napi:"content-type"`
+	Skipped  string         `napi:"-"`
+	internal string
+}
+
+func init() {
+	napi.Export("version", "1.0.0")
+	napi.Export("defaultUser", &User{})
+	napi.ExportFunc("getUser", func(id int) (*User, error) { return nil, nil })
+	napi.ExportFunc("save", func(user User) error { return nil })
+	napi.ExportFunc("optional", func(name string, greeting *string, times napi.Optional[int]) string { return name })
+	napi.ExportFunc("optionalBeforeRequired", func(value *int, name string) {})
+	napi.ExportFunc("sum", func(first int, numbers ...float64) float64 { return 0 })
+	napi.ExportFunc("divide", func(a, b float64) (float64, float64, error) { return 0, 0, nil })
+	napi.ExportFunc("fetch", func(ctx context.Context, url string) ([]byte, error) { return nil, nil })
+	napi.ExportFunc("wait", func(ctx context.Context) error { return nil })
+	napi.ExportFunc("all", func(ctx context.Context, urls ...string) ([]string, error) { return nil, nil })
+	napi.ExportFunc("method", func(this napi.This, value napi.ValueType) *napi.Promise { return nil })
+	napi.ExportFunc("raw", func(ci *napi.CallbackInfo) (napi.ValueType, error) { return nil, nil })
+	napi.ExportFunc("callback", func(fn func(err error, value string)) {})
+	napi.ExportOverload("parse", func(value string) int { return 0 }, func(value []byte, base int) int { return 0 })
+
+	napi.OnInit(func(env napi.EnvType, exports *napi.Object) error {
+		str, _ := napi.CreateString(env, "hello")
+		exports.Set("message", str)
+		date, _ := napi.ValueOf(env, time.Now())
+		exports.Set("started-at", date)
+		fn, _ := napi.GoFuncOf(env, func(users []User, limit napi.Optional[int]) map[string]*User { return nil })
+		exports.Set("index", fn)
+		return nil
+	})
+}
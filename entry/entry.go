@@ -0,0 +1,80 @@
+// Package entry is the entry point of N-API modules with values registered by
+// [napi.Export], [napi.ExportFunc] and [napi.OnInit], without go:linkname.
+//
+// Import entry only in main package, and not import package module in same addon:
+//
+//	package main
+//
+//	import (
+//		"sirherobrine23.com.br/Sirherobrine23/napi-go"
+//		_ "sirherobrine23.com.br/Sirherobrine23/napi-go/entry"
+//	)
+//
+//	func main() {}
+//
+//	func init() {
+//		napi.Export("msg", "hello from Gopher")
+//		napi.ExportFunc("sum", func(a, b int) int { return a + b })
+//	}
+//
+// If have error in registration or init, load of addon throw error in javascript.
+package entry
+
+/*
+#cgo CFLAGS: -DDEBUG
+#cgo CFLAGS: -D_DEBUG
+#cgo CFLAGS: -DV8_ENABLE_CHECKS
+#cgo !napi8,!napi9,!napi10 CFLAGS: -DNAPI_EXPERIMENTAL
+#cgo napi8 CFLAGS: -DNAPI_VERSION=8
+#cgo napi9 CFLAGS: -DNAPI_VERSION=9
+#cgo napi10 CFLAGS: -DNAPI_VERSION=10
+#cgo !napi_system_headers CPPFLAGS: -I${SRCDIR}/../internal/napi/include
+#cgo napi_system_headers CFLAGS: -I/usr/local/include
+#cgo CFLAGS: -DNODE_API_EXPERIMENTAL_BASIC_ENV_OPT_OUT
+#cgo CXXFLAGS: -std=c++11
+
+#cgo darwin LDFLAGS: -Wl,-undefined,dynamic_lookup
+#cgo darwin LDFLAGS: -Wl,-no_pie
+#cgo darwin LDFLAGS: -Wl,-search_paths_first
+#cgo (darwin && amd64) LDFLAGS: -arch x86_64
+#cgo (darwin && arm64) LDFLAGS: -arch arm64
+
+#cgo linux LDFLAGS: -Wl,-unresolved-symbols=ignore-all
+
+#cgo LDFLAGS: -L${SRCDIR}
+
+#include <stdlib.h>
+#include "./entry.h"
+*/
+import "C"
+
+import (
+	"errors"
+	_ "unsafe"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	internal_napi "sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+//export initializeModule
+func initializeModule(cEnv C.napi_env, cExports C.napi_value) C.napi_value {
+	// Start cgo internal napi values
+	internalEnv, internalExports := internal_napi.Env(cEnv), internal_napi.Value(cExports)
+	internal_napi.InitializeInstanceData(internalEnv)
+
+	// Convert to go type
+	env := napi.N_APIEnv(internalEnv)
+	export := napi.ToObject(napi.N_APIValue(env, internalExports))
+
+	// Set registered exports
+	if err := initModule(env, export); err != nil {
+		if !errors.Is(err, napi.ErrPendingException) {
+			internal_napi.ThrowError(env.NapiValue(), "", err.Error())
+		}
+		return nil
+	}
+	return cExports
+}
+
+//go:linkname initModule sirherobrine23.com.br/Sirherobrine23/napi-go.initModule
+func initModule(env napi.EnvType, exports *napi.Object) error
@@ -0,0 +1,5 @@
+//go:build !cgo
+
+package entry
+
+// Without cgo addon can't be built, entry has no effect.
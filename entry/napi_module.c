@@ -0,0 +1,5 @@
+#include <stdlib.h>
+
+#include "./entry.h"
+
+NAPI_MODULE(napiGo, initializeModule)
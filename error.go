@@ -1,6 +1,8 @@
 package napi
 
 import (
+	"errors"
+	"fmt"
 	"runtime"
 
 	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
@@ -17,8 +19,8 @@ func CreateError(env EnvType, msg string) (*Error, error) {
 	if err != nil {
 		return nil, err
 	}
-	napiValue, err := mustValueErr(napi.CreateError(env.NapiValue(), nil, napiMsg.NapiValue()))
-	if err != nil {
+	napiValue, status := napi.CreateError(env.NapiValue(), nil, napiMsg.NapiValue())
+	if err := statusError(env.NapiValue(), "napi_create_error", status); err != nil {
 		return nil, err
 	}
 	return ToError(N_APIValue(env, napiValue)), nil
@@ -27,7 +29,7 @@ func CreateError(env EnvType, msg string) (*Error, error) {
 // ThrowAsJavaScriptException throws the current Error as a JavaScript exception
 // in the associated N-API environment. It returns an error if the operation fails.
 func (er *Error) ThrowAsJavaScriptException() error {
-	return singleMustValueErr(napi.Throw(er.NapiEnv(), er.NapiValue()))
+	return statusError(er.NapiEnv(), "napi_throw", napi.Throw(er.NapiEnv(), er.NapiValue()))
 }
 
 // ThrowError throws a JavaScript error in the given N-API environment with the specified code and error message.
@@ -44,5 +46,78 @@ func ThrowError(env EnvType, code, err string) error {
 		stackTraceSz := runtime.Stack(stackTraceBuf, false)
 		code = string(stackTraceBuf[:stackTraceSz])
 	}
-	return singleMustValueErr(napi.ThrowError(env.NapiValue(), code, err))
+	return statusError(env.NapiValue(), "napi_throw_error", napi.ThrowError(env.NapiValue(), code, err))
+}
+
+// Exception is a Go error with Javascript value thrown, cleared from env
+// with napi_get_and_clear_last_exception.
+//
+// Returning Exception from [Callback] throws Value again in Javascript.
+type Exception struct {
+	Value   ValueType // Value thrown, commonly [*Error]
+	Name    string    // Error name, example: SyntaxError, TypeError
+	Message string    // Error message or value converted to string
+	Stack   string    // Javascript stack trace if exists
+}
+
+var _ error = &Exception{}
+
+func (err *Exception) Error() string {
+	if err.Name == "" {
+		return err.Message
+	}
+	return fmt.Sprintf("%s: %s", err.Name, err.Message)
+}
+
+// ThrowAsJavaScriptException throws the Value again in the associated N-API environment.
+func (err *Exception) ThrowAsJavaScriptException() error {
+	return statusError(err.Value.NapiEnv(), "napi_throw", napi.Throw(err.Value.NapiEnv(), err.Value.NapiValue()))
+}
+
+// Return Javascript value of err, [*Exception] return value thrown and others errors return Error with err.Error()
+func errorValue(env EnvType, err error) (ValueType, error) {
+	var exception *Exception
+	if errors.As(err, &exception) {
+		return exception.Value, nil
+	}
+	jsErr, err := CreateError(env, err.Error())
+	if err != nil {
+		return nil, err
+	}
+	return jsErr, nil
+}
+
+// Return [*Exception] with pending exception cleared from env,
+// if not exception pending return [ErrPendingException] status error.
+func lastException(env EnvType) error {
+	napiValue, status := napi.GetAndClearLastException(env.NapiValue())
+	if err := statusError(env.NapiValue(), "napi_get_and_clear_last_exception", status); err != nil {
+		return err
+	}
+
+	if typeOf, _ := N_APIValue(env, napiValue).Type(); typeOf == TypeUndefined {
+		return statusError(env.NapiValue(), "napi_get_and_clear_last_exception", napi.StatusPendingException)
+	}
+	return exceptionOf(N_APIValue(env, napiValue))
+}
+
+// Return [*Exception] of Javascript value, with name, message and stack if value is object
+func exceptionOf(value ValueType) *Exception {
+	exception := &Exception{Value: value}
+	switch typeOf, _ := exception.Value.Type(); typeOf {
+	case TypeObject, TypeError, TypeFunction:
+		obj := ToObject(exception.Value)
+		for key, target := range map[string]*string{"name": &exception.Name, "message": &exception.Message, "stack": &exception.Stack} {
+			if value, err := obj.Get(key); err == nil {
+				if typeOf, _ := value.Type(); typeOf == TypeString {
+					*target, _ = ToString(value).Utf8Value()
+				}
+			}
+		}
+	default:
+		if str, err := CoerceString(exception.Value); err == nil {
+			exception.Message, _ = str.Utf8Value()
+		}
+	}
+	return exception
 }
@@ -0,0 +1,226 @@
+package napi
+
+import (
+	"errors"
+	"sync"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// ErrEmitterClosed is returned by [EventEmitter.Emit] and [EventEmitter.On] after [EventEmitter.Close]
+var ErrEmitterClosed = errors.New("napi: event emitter closed")
+
+// EventListener is Go function called in Javascript thread when event is emitted in Javascript
+type EventListener func(env EnvType, args []ValueType)
+
+// EventEmitter is Go handle to Node.js EventEmitter, safe to use from any goroutine.
+//
+// Node.js event loop is kept alive while EventEmitter is not closed or has Go listeners.
+type EventEmitter struct {
+	env     EnvType
+	tsfn    *ThreadsafeFunction
+	emitter napi.Reference
+
+	mu     sync.RWMutex
+	closed bool // Close called
+	active int  // Not closed + Go listeners, threadsafe function released when 0
+}
+
+// NewEventEmitter create Node.js EventEmitter and Go handle to emit events and listen events emitted in Javascript.
+//
+//	emitter, events, err := napi.NewEventEmitter(ci.Env)
+//	if err != nil {
+//		return nil, err
+//	}
+//	go func() {
+//		defer events.Close()
+//		for line := range logs {
+//			events.Emit("log", line)
+//		}
+//	}()
+//	return emitter, nil
+func NewEventEmitter(env EnvType) (*Object, *EventEmitter, error) {
+	events, err := env.Require("events")
+	if err != nil {
+		return nil, nil, err
+	}
+	constructor, err := ToObject(events).Get("EventEmitter")
+	if err != nil {
+		return nil, nil, err
+	}
+	emitter, err := newInstance(constructor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ref, status := napi.CreateReference(env.NapiValue(), emitter.NapiValue(), 1)
+	if err = statusError(env.NapiValue(), "napi_create_reference", status); err != nil {
+		return nil, nil, err
+	}
+	handle := &EventEmitter{env: env, emitter: ref, active: 1}
+	handle.tsfn, err = createThreadsafeFunction(env, nil, func(env EnvType, _ any) {
+		napi.DeleteReference(env.NapiValue(), ref)
+	}, func(env EnvType, _ *Function, data any) {
+		data.(func(EnvType))(env)
+	}, "napi-go/event-emitter", 0, 1, nil)
+	if err != nil {
+		napi.DeleteReference(env.NapiValue(), ref)
+		return nil, nil, err
+	}
+	return emitter, handle, nil
+}
+
+// Return Javascript EventEmitter, in Javascript thread
+func (events *EventEmitter) value(env EnvType) (*Object, error) {
+	napiValue, status := napi.GetReferenceValue(env.NapiValue(), events.emitter)
+	if err := statusError(env.NapiValue(), "napi_get_reference_value", status); err != nil {
+		return nil, err
+	}
+	return ToObject(N_APIValue(env, napiValue)), nil
+}
+
+// Queue fn in Javascript thread, caller hold lock
+func (events *EventEmitter) call(fn func(env EnvType)) error {
+	if events.active == 0 {
+		return ErrEmitterClosed
+	}
+	return events.tsfn.Call(fn, NonBlocking)
+}
+
+// Call fn now if in Javascript thread and return error of fn, else queue and error of fn is uncaught exception in Node.js,
+// caller hold lock
+func (events *EventEmitter) run(fn func(env EnvType) error) error {
+	if events.active > 0 && isJSThread(events.env) {
+		return fn(events.env)
+	}
+	return events.call(func(env EnvType) {
+		if err := fn(env); err != nil && !errors.Is(err, ErrPendingException) {
+			ThrowError(env, "", err.Error())
+		}
+	})
+}
+
+// Decrement active and release threadsafe function if 0, caller hold write lock
+func (events *EventEmitter) release() error {
+	if events.active--; events.active == 0 {
+		return events.tsfn.Release(Release)
+	}
+	return nil
+}
+
+// Emit queue emit of event in Javascript thread, args are converted with [ValueOf],
+// Emit return before listeners are called.
+//
+// If listener throw or args cannot be converted, error is uncaught exception in Node.js.
+func (events *EventEmitter) Emit(name string, args ...any) error {
+	events.mu.RLock()
+	defer events.mu.RUnlock()
+	if events.closed {
+		return ErrEmitterClosed
+	}
+	return events.call(func(env EnvType) {
+		if err := events.emit(env, name, args); err != nil && !errors.Is(err, ErrPendingException) {
+			ThrowError(env, "", err.Error())
+		}
+	})
+}
+
+func (events *EventEmitter) emit(env EnvType, name string, args []any) error {
+	emitter, err := events.value(env)
+	if err != nil {
+		return err
+	}
+	jsArgs := make([]ValueType, len(args)+1)
+	if jsArgs[0], err = CreateString(env, name); err != nil {
+		return err
+	}
+	for index, arg := range args {
+		if jsArgs[index+1], err = ValueOf(env, arg); err != nil {
+			return err
+		} else if jsArgs[index+1] == nil {
+			if jsArgs[index+1], err = env.Undefined(); err != nil {
+				return err
+			}
+		}
+	}
+	emit, err := emitter.Get("emit")
+	if err != nil {
+		return err
+	}
+	_, err = ToFunction(emit).CallWithGlobal(emitter, jsArgs...)
+	return err
+}
+
+// On add Go listener to event, listener is called in Javascript thread when event is emitted in Javascript or by [EventEmitter.Emit].
+// Called from Javascript thread listener is added before On return and error adding listener is returned,
+// from other goroutines is queued and error adding listener is uncaught exception in Node.js.
+// off remove listener and is safe to call from any goroutine.
+func (events *EventEmitter) On(name string, listener EventListener) (off func(), err error) {
+	events.mu.Lock()
+	defer events.mu.Unlock()
+	if events.closed {
+		return nil, ErrEmitterClosed
+	}
+
+	var fnRef napi.Reference
+	err = events.run(func(env EnvType) error {
+		emitter, err := events.value(env)
+		if err != nil {
+			return err
+		}
+		fn, err := CreateFunction(env, name, func(ci *CallbackInfo) (ValueType, error) {
+			listener(ci.Env, ci.Args)
+			return nil, nil
+		})
+		if err != nil {
+			return err
+		}
+		ref, status := napi.CreateReference(env.NapiValue(), fn.NapiValue(), 1)
+		if err = statusError(env.NapiValue(), "napi_create_reference", status); err != nil {
+			return err
+		} else if err = callMethod(emitter, "on", name, fn); err != nil {
+			napi.DeleteReference(env.NapiValue(), ref)
+			return err
+		}
+		fnRef = ref
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.active++ // Keep threadsafe function to remove listener
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			events.mu.Lock()
+			defer events.mu.Unlock()
+			events.run(func(env EnvType) error {
+				if fnRef.Ref == nil { // Listener not added
+					return nil
+				}
+				defer napi.DeleteReference(env.NapiValue(), fnRef)
+				emitter, err := events.value(env)
+				if err != nil {
+					return nil
+				}
+				if fn, status := napi.GetReferenceValue(env.NapiValue(), fnRef); status == napi.StatusOK {
+					callMethod(emitter, "removeListener", name, N_APIValue(env, fn))
+				}
+				return nil
+			})
+			events.release()
+		})
+	}, nil
+}
+
+// Close stop emit events from Go, Node.js can exit if has no Go listeners. Events queued by Emit are emitted.
+func (events *EventEmitter) Close() error {
+	events.mu.Lock()
+	defer events.mu.Unlock()
+	if events.closed {
+		return nil
+	}
+	events.closed = true
+	return events.release()
+}
@@ -0,0 +1,205 @@
+package napi_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+// Create EventEmitter and set Javascript emitter to global name
+func newEmitter(t *napitest.T, env napi.EnvType, name string) *napi.EventEmitter {
+	t.Helper()
+	emitter, events, err := napi.NewEventEmitter(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	global, err := env.Global()
+	if err != nil {
+		t.Fatal(err)
+	} else if err = global.Set(name, emitter); err != nil {
+		t.Fatal(err)
+	}
+	return events
+}
+
+// Listener sending arguments as string to channel
+func listenerOf(ch chan<- string) napi.EventListener {
+	return func(env napi.EnvType, args []napi.ValueType) {
+		var values []any
+		for _, arg := range args {
+			var value any
+			if err := napi.ValueFrom(arg, &value); err != nil {
+				ch <- err.Error()
+				return
+			}
+			values = append(values, value)
+		}
+		ch <- fmt.Sprint(values)
+	}
+}
+
+func TestEventEmitterEmit(t *testing.T) {
+	results := make(chan string, 3)
+	var events *napi.EventEmitter
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		events = newEmitter(t, env, "emitEmitter")
+		callDone(t, env, `(done) => emitEmitter.on("data", (...args) => done(JSON.stringify(args)))`, results)
+	})
+	defer events.Close()
+
+	go func() {
+		for _, args := range [][]any{{1, map[string]string{"k": "v"}}, {"text", nil}, {}} {
+			if err := events.Emit("data", args...); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+	for _, want := range []string{`[1,{"k":"v"}]`, `["text",null]`, `[]`} {
+		if got := wait(t, results); got != want {
+			t.Errorf("listener called with %s, want %s", got, want)
+		}
+	}
+}
+
+func TestEventEmitterOn(t *testing.T) {
+	results := make(chan string, 2)
+	var events *napi.EventEmitter
+	var off func()
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		events = newEmitter(t, env, "onEmitter")
+		var err error
+		if off, err = events.On("ping", listenerOf(results)); err != nil { // Added before return
+			t.Fatal(err)
+		}
+		script(t, env, `onEmitter.emit("ping", "js", 1)`)
+	})
+	defer events.Close()
+	if got := wait(t, results); got != "[js 1]" {
+		t.Errorf("Go listener called with %q, want %q", got, "[js 1]")
+	}
+
+	// On from goroutine is queued before Emit
+	offGoroutine, err := events.On("pong", listenerOf(results))
+	if err != nil {
+		t.Fatal(err)
+	} else if err = events.Emit("pong", "go"); err != nil {
+		t.Fatal(err)
+	}
+	if got := wait(t, results); got != "[go]" {
+		t.Errorf("Go listener called with %q, want %q", got, "[go]")
+	}
+
+	off()
+	offGoroutine()
+	off() // Call again is ignored
+	waitJS(t, `onEmitter.listenerCount("ping") === 0 && onEmitter.listenerCount("pong") === 0`)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		script(t, env, `onEmitter.emit("ping", "removed")`)
+	})
+	select {
+	case got := <-results:
+		t.Errorf("removed listener called with %q", got)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestEventEmitterOnError(t *testing.T) {
+	results := make(chan string, 1)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		events := newEmitter(t, env, "errorEmitter")
+		defer events.Close()
+		script(t, env, `errorEmitter.on = () => { throw new Error("on failed") }`)
+
+		var onErr error
+		fn, err := napi.CreateFunction(env, "on", func(ci *napi.CallbackInfo) (napi.ValueType, error) {
+			_, onErr = events.On("data", func(napi.EnvType, []napi.ValueType) {})
+			return nil, onErr
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Error adding listener in Javascript thread is returned, not uncaught exception
+		callDone(t, env, `(on, done) => { try { on(); done("no error") } catch (err) { done(err.message) } }`, results, fn)
+		if !errors.Is(onErr, napi.ErrPendingException) {
+			t.Errorf("On error = %v, want ErrPendingException", onErr)
+		}
+	})
+	if got := wait(t, results); got != "on failed" {
+		t.Errorf("On throw %q, want %q", got, "on failed")
+	}
+}
+
+func TestEventEmitterClose(t *testing.T) {
+	results := make(chan string, 2)
+	var events *napi.EventEmitter
+	var off func()
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		v8, err := env.Require("v8")
+		if err != nil {
+			t.Fatal(err)
+		}
+		vm, err := env.Require("vm")
+		if err != nil {
+			t.Fatal(err)
+		}
+		fn, err := napi.CompileFunction(env, `(v8, vm) => {
+	v8.setFlagsFromString("--expose-gc");
+	globalThis.forceGC = vm.runInNewContext("gc");
+	globalThis.closeCollected = false;
+	globalThis.closeRegistry = new FinalizationRegistry(() => { closeCollected = true });
+}`)
+		if err != nil {
+			t.Fatal(err)
+		} else if _, err = fn.Call(v8, vm); err != nil {
+			t.Fatal(err)
+		}
+
+		emitter, handle, err := napi.NewEventEmitter(env)
+		if err != nil {
+			t.Fatal(err)
+		}
+		events = handle
+		register, err := napi.CompileFunction(env, `(emitter) => { globalThis.closeEmitter = new WeakRef(emitter); closeRegistry.register(emitter) }`)
+		if err != nil {
+			t.Fatal(err)
+		} else if _, err = register.Call(emitter); err != nil {
+			t.Fatal(err)
+		}
+		if off, err = events.On("data", listenerOf(results)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if err := events.Emit("data", "before close"); err != nil {
+		t.Fatal(err)
+	} else if err = events.Close(); err != nil {
+		t.Fatal(err)
+	} else if err = events.Close(); err != nil {
+		t.Errorf("Close again = %v", err)
+	}
+	if err := events.Emit("data", "after close"); !errors.Is(err, napi.ErrEmitterClosed) {
+		t.Errorf("Emit after Close = %v, want ErrEmitterClosed", err)
+	}
+	if _, err := events.On("data", listenerOf(results)); !errors.Is(err, napi.ErrEmitterClosed) {
+		t.Errorf("On after Close = %v, want ErrEmitterClosed", err)
+	}
+	if got := wait(t, results); got != "[before close]" {
+		t.Errorf("Go listener called with %q, want event queued before Close", got)
+	}
+
+	// Go listener keep emitter after Close
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		script(t, env, `forceGC(), closeEmitter.deref()?.emit("data", "listener")`)
+	})
+	if got := wait(t, results); got != "[listener]" {
+		t.Errorf("Go listener called with %q, want %q", got, "[listener]")
+	}
+
+	// Threadsafe function released after last listener removed, emitter is not referenced by Go
+	off()
+	waitJS(t, `forceGC(), closeCollected`)
+}
@@ -0,0 +1,236 @@
+package napi
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+var (
+	ErrExecutorFull   = errors.New("napi: executor queue is full")
+	ErrExecutorClosed = errors.New("napi: executor closed")
+)
+
+// ExecutorOptions configure [NewExecutor]
+type ExecutorOptions struct {
+	MaxConcurrency int  // Max functions running at same time, default is runtime.GOMAXPROCS(0)
+	QueueSize      int  // Max functions waiting to run, 0 only run if has free worker
+	Block          bool // If queue is full block caller until has space, default reject with ErrExecutorFull
+}
+
+// ExecutorStats is snapshot of jobs of [Executor]
+type ExecutorStats struct {
+	Queued    int // Waiting worker
+	Running   int // Running now
+	Completed int // Finished
+	Rejected  int // Rejected with ErrExecutorFull
+}
+
+// Executor run async Go functions in bounded goroutines, independent of libuv threadpool used by [CreateAsyncWorker].
+//
+// Executor is used by async Go functions (context.Context as first argument) converted with [Executor.GoFuncOf] and [Executor.ExportFunc],
+// or all of env with [SetExecutor], and by [Submit]. Without executor async functions run in new goroutine.
+//
+// In Block mode, functions called from Javascript block Javascript thread while queue is full,
+// do not use Block with jobs that wait Javascript thread, like [RunOnJS].
+type Executor struct {
+	opts ExecutorOptions
+	jobs chan func()
+	done chan struct{}
+
+	mu      sync.RWMutex
+	closed  bool
+	senders sync.WaitGroup // Blocked in submit, workers wait before exit
+
+	queued, running, completed, rejected atomic.Int64
+}
+
+// NewExecutor start workers of executor, call [Executor.Close] to stop workers.
+func NewExecutor(opts ExecutorOptions) *Executor {
+	if opts.MaxConcurrency <= 0 {
+		opts.MaxConcurrency = runtime.GOMAXPROCS(0)
+	}
+	opts.QueueSize = max(opts.QueueSize, 0)
+
+	executor := &Executor{opts: opts, jobs: make(chan func(), opts.QueueSize), done: make(chan struct{})}
+	for range opts.MaxConcurrency {
+		go executor.worker()
+	}
+	return executor
+}
+
+func (executor *Executor) worker() {
+	for {
+		select {
+		case job := <-executor.jobs:
+			executor.run(job)
+		case <-executor.done: // Run jobs in queue and exit
+			executor.senders.Wait()
+			for {
+				select {
+				case job := <-executor.jobs:
+					executor.run(job)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (executor *Executor) run(job func()) {
+	executor.queued.Add(-1)
+	executor.running.Add(1)
+	defer func() {
+		executor.running.Add(-1)
+		executor.completed.Add(1)
+	}()
+	job() // Jobs of runAsync recover panic and reject promise
+}
+
+// Queue job, return ErrExecutorFull if queue is full and not Block or ErrExecutorClosed
+func (executor *Executor) submit(job func()) error {
+	executor.mu.RLock()
+	if executor.closed {
+		executor.mu.RUnlock()
+		return ErrExecutorClosed
+	}
+
+	executor.queued.Add(1)
+	if !executor.opts.Block {
+		defer executor.mu.RUnlock()
+		select {
+		case executor.jobs <- job:
+			return nil
+		default:
+			executor.queued.Add(-1)
+			executor.rejected.Add(1)
+			return ErrExecutorFull
+		}
+	}
+
+	// Wait space without lock, Close not wait blocked callers
+	executor.senders.Add(1)
+	executor.mu.RUnlock()
+	defer executor.senders.Done()
+	select {
+	case executor.jobs <- job:
+		return nil
+	case <-executor.done:
+		executor.queued.Add(-1)
+		return ErrExecutorClosed
+	}
+}
+
+// Stats return current jobs of executor
+func (executor *Executor) Stats() ExecutorStats {
+	return ExecutorStats{
+		Queued:    int(executor.queued.Load()),
+		Running:   int(executor.running.Load()),
+		Completed: int(executor.completed.Load()),
+		Rejected:  int(executor.rejected.Load()),
+	}
+}
+
+// Close stop accept new jobs, jobs in queue are run before workers exit.
+// Callers blocked by full queue in Block mode return [ErrExecutorClosed].
+func (executor *Executor) Close() error {
+	executor.mu.Lock()
+	defer executor.mu.Unlock()
+	if !executor.closed {
+		executor.closed = true
+		close(executor.done)
+	}
+	return nil
+}
+
+// GoFuncOf same of [GoFuncOf], async functions run in executor.
+func (executor *Executor) GoFuncOf(env EnvType, function any) (ValueType, error) {
+	return executorFuncOf(env, "", reflect.ValueOf(function), executor)
+}
+
+// ExportFunc same of [ExportFunc], async functions run in executor.
+func (executor *Executor) ExportFunc(name string, function any) {
+	ptr := reflect.ValueOf(function)
+	if ptr.Kind() != reflect.Func || ptr.IsNil() {
+		moduleExports.Lock()
+		moduleExports.errs = append(moduleExports.errs, fmt.Errorf("napi: export %q at %s: require function, got %T", name, caller(), function))
+		moduleExports.Unlock()
+		return
+	}
+	registerExport(name, caller(), func(env EnvType) (ValueType, error) { return executorFuncOf(env, name, ptr, executor) })
+}
+
+type executorKey struct{}
+
+// SetExecutor set executor to async functions of env without executor, nil to run in new goroutines.
+func SetExecutor(env EnvType, executor *Executor) error {
+	data, err := libraryData(env)
+	if err != nil {
+		return err
+	} else if executor == nil {
+		data.Delete(executorKey{})
+		return nil
+	}
+	data.Store(executorKey{}, executor)
+	return nil
+}
+
+// Return instance data of env, created on module init
+func libraryData(env EnvType) (*sync.Map, error) {
+	data, status := napi.GetLibraryData(env.NapiValue())
+	if err := statusError(env.NapiValue(), "napi_get_instance_data", status); err != nil {
+		return nil, err
+	} else if data == nil {
+		return nil, fmt.Errorf("napi-go instance data not initialized in env")
+	}
+	return data, nil
+}
+
+// Run job in executor, executor of env or new goroutine
+func runAsync(env EnvType, executor *Executor, job func()) error {
+	if executor == nil {
+		if data, err := libraryData(env); err == nil {
+			if value, ok := data.Load(executorKey{}); ok {
+				executor = value.(*Executor)
+			}
+		}
+	}
+	if executor == nil {
+		go job()
+		return nil
+	}
+	return executor.submit(job)
+}
+
+// Submit run fn in executor and return promise resolved with result, converted with [ValueOf].
+// If executor is nil use executor of env set with [SetExecutor] or new goroutine.
+//
+// Promise is rejected with [ErrExecutorFull] if queue of executor is full or [ErrExecutorClosed] if executor is closed.
+func Submit[T any](env EnvType, executor *Executor, fn func() (T, error)) (*Promise, error) {
+	deferred, err := NewDeferred[T](env)
+	if err != nil {
+		return nil, err
+	}
+	err = runAsync(env, executor, func() {
+		defer func() {
+			if v := recover(); v != nil {
+				deferred.Reject(fmt.Errorf("panic recover: %v", v))
+			}
+		}()
+		if value, err := fn(); err != nil {
+			deferred.Reject(err)
+		} else {
+			deferred.Resolve(value)
+		}
+	})
+	if err != nil {
+		deferred.Reject(err)
+	}
+	return deferred.Promise(), nil
+}
@@ -0,0 +1,210 @@
+package napi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+// Wait stats of executor, completed is counted after job settle promise
+func waitStats(t *testing.T, executor *napi.Executor, want napi.ExecutorStats) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for executor.Stats() != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("executor stats %+v, want %+v", executor.Stats(), want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Submit job waiting release to executor, started is closed when job run
+func submitWait(t *napitest.T, env napi.EnvType, executor *napi.Executor, started chan<- struct{}, release <-chan struct{}, results chan<- settled) {
+	t.Helper()
+	promise, err := napi.Submit(env, executor, func() (string, error) {
+		if started != nil {
+			close(started)
+		}
+		<-release
+		return "done", nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	await(t, env, promise, results)
+}
+
+func TestExecutorReject(t *testing.T) {
+	executor := napi.NewExecutor(napi.ExecutorOptions{MaxConcurrency: 1, QueueSize: 1})
+	defer executor.Close()
+	started, release := make(chan struct{}), make(chan struct{})
+	results := make(chan settled, 3)
+
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		submitWait(t, env, executor, started, release, results)
+	})
+	wait(t, started)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		submitWait(t, env, executor, nil, release, results) // Queued
+		submitWait(t, env, executor, nil, release, results) // Queue full
+	})
+	if got, want := wait(t, results), (settled{"rejected", napi.ErrExecutorFull.Error()}); got != want {
+		t.Errorf("promise %s %s, want %s %s", got.State, got.Value, want.State, want.Value)
+	}
+	waitStats(t, executor, napi.ExecutorStats{Queued: 1, Running: 1, Rejected: 1})
+
+	close(release)
+	for range 2 {
+		if got, want := wait(t, results), (settled{"resolved", `"done"`}); got != want {
+			t.Errorf("promise %s %s, want %s %s", got.State, got.Value, want.State, want.Value)
+		}
+	}
+	waitStats(t, executor, napi.ExecutorStats{Completed: 2, Rejected: 1})
+}
+
+func TestExecutorBlock(t *testing.T) {
+	executor := napi.NewExecutor(napi.ExecutorOptions{MaxConcurrency: 1, Block: true})
+	defer executor.Close()
+	started, release := make(chan struct{}), make(chan struct{})
+	results := make(chan settled, 2)
+
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		submitWait(t, env, executor, started, release, results)
+	})
+	wait(t, started)
+	time.AfterFunc(50*time.Millisecond, func() { close(release) })
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		submitWait(t, env, executor, nil, release, results) // Block until worker is free
+		select {
+		case <-release:
+		default:
+			t.Error("Submit returned before worker is free")
+		}
+	})
+	for range 2 {
+		if got, want := wait(t, results), (settled{"resolved", `"done"`}); got != want {
+			t.Errorf("promise %s %s, want %s %s", got.State, got.Value, want.State, want.Value)
+		}
+	}
+	waitStats(t, executor, napi.ExecutorStats{Completed: 2})
+}
+
+func TestExecutorClose(t *testing.T) {
+	t.Run("queued jobs run", func(t *testing.T) {
+		executor := napi.NewExecutor(napi.ExecutorOptions{MaxConcurrency: 1, QueueSize: 1})
+		started, release := make(chan struct{}), make(chan struct{})
+		results := make(chan settled, 3)
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+			submitWait(t, env, executor, started, release, results)
+		})
+		wait(t, started)
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+			submitWait(t, env, executor, nil, release, results)
+			if err := executor.Close(); err != nil {
+				t.Fatal(err)
+			}
+			submitWait(t, env, executor, nil, release, results) // After Close
+		})
+		if got, want := wait(t, results), (settled{"rejected", napi.ErrExecutorClosed.Error()}); got != want {
+			t.Errorf("promise %s %s, want %s %s", got.State, got.Value, want.State, want.Value)
+		}
+		close(release)
+		for range 2 {
+			if got, want := wait(t, results), (settled{"resolved", `"done"`}); got != want {
+				t.Errorf("promise %s %s, want %s %s", got.State, got.Value, want.State, want.Value)
+			}
+		}
+		waitStats(t, executor, napi.ExecutorStats{Completed: 2})
+	})
+
+	t.Run("blocked submit", func(t *testing.T) {
+		executor := napi.NewExecutor(napi.ExecutorOptions{MaxConcurrency: 1, Block: true})
+		started, release := make(chan struct{}), make(chan struct{})
+		defer close(release)
+		results := make(chan settled, 2)
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+			submitWait(t, env, executor, started, release, results)
+		})
+		wait(t, started)
+		time.AfterFunc(50*time.Millisecond, func() { executor.Close() })
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+			submitWait(t, env, executor, nil, release, results) // Block until Close
+		})
+		if got, want := wait(t, results), (settled{"rejected", napi.ErrExecutorClosed.Error()}); got != want {
+			t.Errorf("promise %s %s, want %s %s", got.State, got.Value, want.State, want.Value)
+		}
+	})
+}
+
+func TestExecutorPanic(t *testing.T) {
+	executor := napi.NewExecutor(napi.ExecutorOptions{MaxConcurrency: 1, QueueSize: 1})
+	defer executor.Close()
+	results := make(chan settled, 2)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		promise, err := napi.Submit(env, executor, func() (int, error) { panic("boom") })
+		if err != nil {
+			t.Fatal(err)
+		}
+		await(t, env, promise, results)
+	})
+	if got, want := wait(t, results), (settled{"rejected", "panic recover: boom"}); got != want {
+		t.Errorf("promise %s %s, want %s %s", got.State, got.Value, want.State, want.Value)
+	}
+
+	// Worker keep running
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		promise, err := napi.Submit(env, executor, func() (int, error) { return 1, nil })
+		if err != nil {
+			t.Fatal(err)
+		}
+		await(t, env, promise, results)
+	})
+	if got, want := wait(t, results), (settled{"resolved", "1"}); got != want {
+		t.Errorf("promise %s %s, want %s %s", got.State, got.Value, want.State, want.Value)
+	}
+	waitStats(t, executor, napi.ExecutorStats{Completed: 2})
+}
+
+func TestExecutorGoFuncOf(t *testing.T) {
+	own, global := napi.NewExecutor(napi.ExecutorOptions{QueueSize: 3}), napi.NewExecutor(napi.ExecutorOptions{QueueSize: 3})
+	defer own.Close()
+	defer global.Close()
+	results := make(chan settled, 3)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		if err := napi.SetExecutor(env, global); err != nil {
+			t.Fatal(err)
+		}
+		defer napi.SetExecutor(env, nil)
+
+		double := func(ctx context.Context, n int) int { return n * 2 }
+		ownFn, err := own.GoFuncOf(env, double)
+		if err != nil {
+			t.Fatal(err)
+		}
+		envFn, err := napi.GoFuncOf(env, double)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, fn := range []napi.ValueType{ownFn, envFn, envFn} {
+			arg, err := napi.CreateNumber(env, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			promise, err := napi.ToFunction(fn).Call(arg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			await(t, env, promise, results)
+		}
+	})
+	for range 3 {
+		if got, want := wait(t, results), (settled{"resolved", "4"}); got != want {
+			t.Errorf("promise %s %s, want %s %s", got.State, got.Value, want.State, want.Value)
+		}
+	}
+	waitStats(t, own, napi.ExecutorStats{Completed: 1})
+	waitStats(t, global, napi.ExecutorStats{Completed: 2})
+}
@@ -0,0 +1,237 @@
+package napi
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	_ "unsafe" // go:linkname
+)
+
+// Values registered by [Export], [ExportFunc] and [OnInit]
+var moduleExports struct {
+	sync.Mutex
+	entries []moduleEntry
+	names   map[string]string // export name to registration location
+	errs    []error
+}
+
+type moduleEntry struct {
+	Name  string                                   // Export name, empty to OnInit
+	Value func(env EnvType) (ValueType, error)     // Lazy value to export
+	Init  func(env EnvType, exports *Object) error // OnInit callback
+	From  string                                   // file:line of registration
+}
+
+// Export register value to be exported from addon with name, value is converted with [ValueOf].
+//
+// Export is called from init() functions, of any package, and value is only converted
+// on first access of export, the property is defined as getter and replaced by value after first get.
+// If the name already exported, load of addon throw error with both locations.
+//
+//	func init() {
+//		napi.Export("version", "1.0.0")
+//		napi.Export("config", &Config{Name: "example"})
+//	}
+func Export(name string, value any) {
+	registerExport(name, caller(), func(env EnvType) (ValueType, error) { return ValueOf(env, value) })
+}
+
+// ExportFunc register Go function to be exported from addon with name, function is converted with [GoFuncOf]
+// and named with export name. [Callback] functions are exported without reflection.
+//
+//	func init() {
+//		napi.ExportFunc("sum", func(a, b int) int { return a + b })
+//	}
+func ExportFunc(name string, function any) {
+	ptr := reflect.ValueOf(function)
+	if ptr.Kind() != reflect.Func || ptr.IsNil() {
+		moduleExports.Lock()
+		moduleExports.errs = append(moduleExports.errs, fmt.Errorf("napi: export %q at %s: require function, got %T", name, caller(), function))
+		moduleExports.Unlock()
+		return
+	}
+	registerExport(name, caller(), func(env EnvType) (ValueType, error) { return namedFuncOf(env, name, ptr) })
+}
+
+// OnInit register function to be called on addon load with exports object,
+// if return error or panic, load of addon throw error.
+//
+//	func init() {
+//		napi.OnInit(func(env napi.EnvType, exports *napi.Object) error {
+//			str, err := napi.CreateString(env, "hello from Gopher")
+//			if err != nil {
+//				return err
+//			}
+//			return exports.Set("msg", str)
+//		})
+//	}
+func OnInit(fn func(env EnvType, exports *Object) error) {
+	moduleExports.Lock()
+	defer moduleExports.Unlock()
+	moduleExports.entries = append(moduleExports.entries, moduleEntry{Init: fn, From: caller()})
+}
+
+func registerExport(name, from string, value func(env EnvType) (ValueType, error)) {
+	moduleExports.Lock()
+	defer moduleExports.Unlock()
+	if moduleExports.names == nil {
+		moduleExports.names = map[string]string{}
+	}
+	if previous, ok := moduleExports.names[name]; ok {
+		moduleExports.errs = append(moduleExports.errs, fmt.Errorf("napi: export %q at %s already registered at %s", name, from, previous))
+		return
+	}
+	moduleExports.names[name] = from
+	moduleExports.entries = append(moduleExports.entries, moduleEntry{Name: name, Value: value, From: from})
+}
+
+// Return file:line of caller of Export, ExportFunc or OnInit
+func caller() string {
+	_, file, line, ok := runtime.Caller(2)
+	if !ok {
+		return "unknown"
+	}
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// Set values registered by [Export], [ExportFunc] and [OnInit] in exports,
+// in same order of registration, Go call init() of packages in deterministic order.
+//
+// initModule is called by packages entry and module on addon load, and start dispatcher of [RunOnJS] and [Post].
+//
+//go:linkname initModule
+func initModule(env EnvType, exports *Object) error {
+	moduleExports.Lock()
+	entries, errs := moduleExports.entries, moduleExports.errs
+	moduleExports.Unlock()
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	} else if err := startDispatcher(env); err != nil {
+		return fmt.Errorf("napi: start dispatcher: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.Init != nil {
+			if err := callInit(env, exports, entry); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if exists, err := exports.HasOwnPropertyString(entry.Name); err != nil {
+			return err
+		} else if exists {
+			return fmt.Errorf("napi: export %q at %s already set in exports", entry.Name, entry.From)
+		} else if err = defineLazy(env, exports, entry); err != nil {
+			return fmt.Errorf("napi: export %q at %s: %w", entry.Name, entry.From, err)
+		}
+	}
+	return nil
+}
+
+// Call OnInit function and recover panic
+func callInit(env EnvType, exports *Object, entry moduleEntry) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("napi: init at %s: panic recover: %v", entry.From, v)
+		}
+	}()
+	if err = entry.Init(env, exports); err != nil && !errors.Is(err, ErrPendingException) {
+		err = fmt.Errorf("napi: init at %s: %w", entry.From, err)
+	}
+	return
+}
+
+// Define export as getter, on first get convert Go value and replace getter by value
+func defineLazy(env EnvType, exports *Object, entry moduleEntry) error {
+	getter, err := CreateFunction(env, entry.Name, func(ci *CallbackInfo) (ValueType, error) {
+		value, err := entry.Value(ci.Env)
+		if err != nil {
+			return nil, err
+		} else if value == nil {
+			if value, err = ci.Env.Undefined(); err != nil {
+				return nil, err
+			}
+		}
+		return value, defineValue(ci.This, entry.Name, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Assign replace getter by value
+	setter, err := CreateFunction(env, entry.Name, func(ci *CallbackInfo) (ValueType, error) {
+		if len(ci.Args) == 0 {
+			return nil, nil
+		}
+		return nil, defineValue(ci.This, entry.Name, ci.Args[0])
+	})
+	if err != nil {
+		return err
+	}
+
+	descriptor, err := CreateObject(env)
+	if err != nil {
+		return err
+	}
+	for key, value := range map[string]ValueType{"get": getter, "set": setter} {
+		if err = descriptor.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return defineProperty(exports, entry.Name, descriptor)
+}
+
+// Define property with value, writable, enumerable and configurable same of assign
+func defineValue(this ValueType, name string, value ValueType) error {
+	descriptor, err := CreateObject(this.Env())
+	if err != nil {
+		return err
+	} else if err = descriptor.Set("value", value); err != nil {
+		return err
+	}
+	writable, err := CreateBoolean(this.Env(), true)
+	if err != nil {
+		return err
+	} else if err = descriptor.Set("writable", writable); err != nil {
+		return err
+	}
+	return defineProperty(ToObject(this), name, descriptor)
+}
+
+// Call Object.defineProperty(obj, name, descriptor) with enumerable and configurable descriptor
+func defineProperty(obj *Object, name string, descriptor *Object) error {
+	for _, key := range []string{"enumerable", "configurable"} {
+		value, err := CreateBoolean(obj.Env(), true)
+		if err != nil {
+			return err
+		} else if err = descriptor.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return objectDefineProperty(obj, name, descriptor)
+}
+
+// Call Object.defineProperty(obj, name, descriptor)
+func objectDefineProperty(obj ValueType, name string, descriptor *Object) error {
+	global, err := obj.Env().Global()
+	if err != nil {
+		return err
+	}
+	objectClass, err := global.Get("Object")
+	if err != nil {
+		return err
+	}
+	define, err := ToObject(objectClass).Get("defineProperty")
+	if err != nil {
+		return err
+	}
+	key, err := CreateString(obj.Env(), name)
+	if err != nil {
+		return err
+	}
+	_, err = ToFunction(define).CallWithGlobal(objectClass, obj, key, descriptor)
+	return err
+}
@@ -15,7 +15,7 @@ func ToExternal(o ValueType) *External { return &External{o} }
 // callback and a finalize hint, which will be called when the external is garbage collected.
 func CreateExternal(env EnvType, data unsafe.Pointer, finalize napi.Finalize, finalizeHint unsafe.Pointer) (*External, error) {
 	napiValue, status := napi.CreateExternal(env.NapiValue(), data, finalize, finalizeHint)
-	if err := status.ToError(); err != nil {
+	if err := statusError(env.NapiValue(), "napi_create_external", status); err != nil {
 		return nil, err
 	}
 	return ToExternal(N_APIValue(env, napiValue)), nil
@@ -25,7 +25,7 @@ func CreateExternal(env EnvType, data unsafe.Pointer, finalize napi.Finalize, fi
 // Returns the pointer and an error, if any occurred during retrieval.
 func (ext *External) Value() (unsafe.Pointer, error) {
 	ptr, status := napi.GetValueExternal(ext.NapiEnv(), ext.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(ext.NapiEnv(), "napi_get_value_external", status); err != nil {
 		return nil, err
 	}
 	return ptr, nil
@@ -1,6 +1,7 @@
 package napi
 
 import (
+	"errors"
 	"fmt"
 
 	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
@@ -21,16 +22,20 @@ type CallbackInfo struct {
 	Args []ValueType
 
 	info napi.CallbackInfo
+	data any
 }
 
 func (call *CallbackInfo) NewTarget() (ValueType, error) {
 	v, status := napi.GetNewTarget(call.Env.NapiValue(), call.info)
-	if err := status.ToError(); err != nil {
+	if err := statusError(call.Env.NapiValue(), "napi_get_new_target", status); err != nil {
 		return nil, err
 	}
 	return N_APIValue(call.Env, v), nil
 }
 
+// Data return data of function created with [CreateFunctionWithData]
+func (call *CallbackInfo) Data() any { return call.data }
+
 // Convert [ValueType] to [*Function]
 func ToFunction(o ValueType) *Function { return &Function{o, nil} }
 
@@ -40,10 +45,15 @@ func ToFunction(o ValueType) *Function { return &Function{o, nil} }
 // exceptions. If the callback returns nil, the JavaScript 'undefined' value is returned. If the callback returns a value
 // of TypeError, it is thrown as a JavaScript exception.
 func CreateFunction(env EnvType, name string, callback Callback) (*Function, error) {
+	return CreateFunctionWithData(env, name, nil, callback)
+}
+
+// CreateFunctionWithData same of [CreateFunction] with data of function, returned by [CallbackInfo.Data] in every call.
+func CreateFunctionWithData(env EnvType, name string, data any, callback Callback) (*Function, error) {
 	return CreateFunctionNapi(env, name, func(napiEnv napi.Env, info napi.CallbackInfo) napi.Value {
 		env := N_APIEnv(napiEnv)
 		cbInfo, status := napi.GetCbInfo(napiEnv, info)
-		if err := status.ToError(); err != nil {
+		if err := statusError(napiEnv, "napi_get_cb_info", status); err != nil {
 			ThrowError(env, "", err.Error())
 			return nil
 		}
@@ -65,8 +75,18 @@ func CreateFunction(env EnvType, name string, callback Callback) (*Function, err
 			}
 		}()
 
-		res, err := callback(&CallbackInfo{env, this, args, info})
+		res, err := callback(&CallbackInfo{Env: env, This: this, Args: args, info: info, data: data})
+		var exception *Exception
+		var typeErr interface{ typeErrorCode() string }
 		switch {
+		case errors.Is(err, ErrPendingException): // javascript exception already pending, dont overwrite
+			return nil
+		case errors.As(err, &exception): // throw javascript value again
+			exception.ThrowAsJavaScriptException()
+			return nil
+		case errors.As(err, &typeErr): // TypeError with Node.js code, example: *ArgumentError
+			napi.ThrowTypeError(napiEnv, typeErr.typeErrorCode(), err.Error())
+			return nil
 		case err != nil:
 			ThrowError(env, "", err.Error())
 			return nil
@@ -86,8 +106,8 @@ func CreateFunction(env EnvType, name string, callback Callback) (*Function, err
 
 // Create function from internal [napi.Callback]
 func CreateFunctionNapi(env EnvType, name string, callback napi.Callback) (*Function, error) {
-	fnCall, err := napi.CreateFunction(env.NapiValue(), name, callback)
-	if err := err.ToError(); err != nil {
+	fnCall, status := napi.CreateFunction(env.NapiValue(), name, callback)
+	if err := statusError(env.NapiValue(), "napi_create_function", status); err != nil {
 		return nil, err
 	}
 	fn := ToFunction(N_APIValue(env, fnCall))
@@ -101,8 +121,8 @@ func (fn *Function) NapiCallback() napi.Callback {
 
 func (fn *Function) internalCall(this napi.Value, argc int, argv []napi.Value) (ValueType, error) {
 	// napi_call_function(env, global, add_two, argc, argv, &return_val);
-	res, err := napi.CallFunction(fn.NapiEnv(), this, fn.NapiValue(), argc, argv)
-	if err := err.ToError(); err != nil {
+	res, status := napi.CallFunction(fn.NapiEnv(), this, fn.NapiValue(), argc, argv)
+	if err := statusError(fn.NapiEnv(), "napi_call_function", status); err != nil {
 		return nil, err
 	}
 	return N_APIValue(fn.Env(), res), nil
@@ -126,3 +146,110 @@ func (fn *Function) Call(args ...ValueType) (ValueType, error) {
 	}
 	return fn.CallWithGlobal(global, args...)
 }
+
+// New create object with function as constructor, same of new fn(...args)
+func (fn *Function) New(args ...ValueType) (*Object, error) {
+	return newInstance(fn, args...)
+}
+
+// Bind return function calling fn with this and args before arguments of call, same of fn.bind(this, ...args).
+// If this is nil, this is undefined.
+func (fn *Function) Bind(this ValueType, args ...ValueType) (*Function, error) {
+	this, err := fn.thisOrUndefined(this)
+	if err != nil {
+		return nil, err
+	}
+	bound, err := fn.callPrototype("bind", append([]ValueType{this}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	return ToFunction(bound), nil
+}
+
+// Apply call function with this and arguments from array or array like object, same of fn.apply(this, args).
+// If this is nil, this is undefined.
+func (fn *Function) Apply(this ValueType, args ValueType) (ValueType, error) {
+	this, err := fn.thisOrUndefined(this)
+	if err != nil {
+		return nil, err
+	}
+	if args == nil {
+		if args, err = fn.Env().Undefined(); err != nil {
+			return nil, err
+		}
+	}
+	return fn.callPrototype("apply", this, args)
+}
+
+// Name return name property of function
+func (fn *Function) Name() (string, error) {
+	name, err := ToObject(fn).Get("name")
+	if err != nil {
+		return "", err
+	}
+	return ToString(name).Utf8Value()
+}
+
+// SetName change name property of function, example in stack traces and console.log
+func (fn *Function) SetName(name string) error {
+	value, err := CreateString(fn.Env(), name)
+	if err != nil {
+		return err
+	}
+	return fn.defineReadonly("name", value)
+}
+
+// SetLength change length property of function, count of arguments expected by function
+func (fn *Function) SetLength(length int) error {
+	value, err := CreateNumber(fn.Env(), length)
+	if err != nil {
+		return err
+	}
+	return fn.defineReadonly("length", value)
+}
+
+// Call method of Function.prototype with function as this
+func (fn *Function) callPrototype(method string, args ...ValueType) (ValueType, error) {
+	call, err := ToObject(fn).Get(method)
+	if err != nil {
+		return nil, err
+	}
+	return ToFunction(call).CallWithGlobal(fn, args...)
+}
+
+func (fn *Function) thisOrUndefined(this ValueType) (ValueType, error) {
+	if this != nil {
+		return this, nil
+	}
+	return fn.Env().Undefined()
+}
+
+// Define property same of name and length of functions: not writable, not enumerable and configurable
+func (fn *Function) defineReadonly(name string, value ValueType) error {
+	descriptor, err := CreateObject(fn.Env())
+	if err != nil {
+		return err
+	} else if err = descriptor.Set("value", value); err != nil {
+		return err
+	}
+	configurable, err := CreateBoolean(fn.Env(), true)
+	if err != nil {
+		return err
+	} else if err = descriptor.Set("configurable", configurable); err != nil {
+		return err
+	}
+	return objectDefineProperty(fn, name, descriptor)
+}
+
+// Create object with constructor and args, same of new constructor(...args)
+func newInstance(constructor ValueType, args ...ValueType) (*Object, error) {
+	argv := make([]napi.Value, len(args))
+	for index := range args {
+		argv[index] = args[index].NapiValue()
+	}
+	napiValue, status := napi.NewInstance(constructor.NapiEnv(), constructor.NapiValue(), len(argv), argv)
+	if err := statusError(constructor.NapiEnv(), "napi_new_instance", status); err != nil {
+		return nil, err
+	}
+	return ToObject(N_APIValue(constructor.Env(), napiValue)), nil
+}
@@ -0,0 +1,164 @@
+package napi_test
+
+import (
+	"errors"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+// Javascript function returning this and arguments of call
+const thisAndArgs = `(() => {
+	"use strict";
+	return function (...args) { return { this: this === undefined ? "undefined" : this.name, args } };
+})()`
+
+// Call Javascript function with values and return describe of result
+func callDescribe(t *napitest.T, env napi.EnvType, source string, args ...napi.ValueType) string {
+	t.Helper()
+	fn, err := napi.CompileFunction(env, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := fn.Call(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return describe(t, res)
+}
+
+// Convert Go values to Javascript values
+func valuesOf(t *napitest.T, env napi.EnvType, values ...any) []napi.ValueType {
+	t.Helper()
+	args := make([]napi.ValueType, len(values))
+	for index, value := range values {
+		var err error
+		if args[index], err = napi.ValueOf(env, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return args
+}
+
+func TestFunctionNew(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		point := napi.ToFunction(script(t, env, `(function Point(x, y) { this.x = x; this.y = y })`))
+		object, err := point.New(valuesOf(t, env, 1, 2)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := callDescribe(t, env, `(object, Point) => [object, object instanceof Point]`, object, point); got != `[{"x":1,"y":2},true]` {
+			t.Errorf("new Point(1, 2) = %s", got)
+		}
+
+		// Arrow function is not constructor, exception is pending until return to Javascript
+		arrow := napi.ToFunction(script(t, env, `() => {}`))
+		var newErr error
+		newArrow, err := napi.CreateFunction(env, "newArrow", func(ci *napi.CallbackInfo) (napi.ValueType, error) {
+			_, newErr = arrow.New()
+			return nil, newErr
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := callDescribe(t, env, `(newArrow) => { try { newArrow() } catch (err) { return err.name } }`, newArrow); got != `"TypeError"` {
+			t.Errorf("new of arrow function throw %s, want TypeError", got)
+		} else if !errors.Is(newErr, napi.ErrPendingException) {
+			t.Errorf("New error = %v, want ErrPendingException", newErr)
+		}
+	})
+}
+
+func TestFunctionBind(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		fn := napi.ToFunction(script(t, env, thisAndArgs))
+		this, err := napi.ValueOf(env, map[string]string{"name": "bound"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		bound, err := fn.Bind(this, valuesOf(t, env, 1, "a")...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := bound.Call(valuesOf(t, env, true)...)
+		if err != nil {
+			t.Fatal(err)
+		} else if got := describe(t, res); got != `{"this":"bound","args":[1,"a",true]}` {
+			t.Errorf("bound call = %s", got)
+		}
+		if name, err := bound.Name(); err != nil || name != "bound " {
+			t.Errorf("bound name = %q, %v, want %q", name, err, "bound ")
+		}
+
+		// Without this, this is undefined
+		if bound, err = fn.Bind(nil); err != nil {
+			t.Fatal(err)
+		}
+		if got := callDescribe(t, env, `(bound) => bound(2)`, bound); got != `{"this":"undefined","args":[2]}` {
+			t.Errorf("bound without this = %s", got)
+		}
+	})
+}
+
+func TestFunctionApply(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		fn := napi.ToFunction(script(t, env, thisAndArgs))
+		values := valuesOf(t, env, map[string]string{"name": "apply"}, []any{1, "b"})
+		res, err := fn.Apply(values[0], values[1])
+		if err != nil {
+			t.Fatal(err)
+		} else if got := describe(t, res); got != `{"this":"apply","args":[1,"b"]}` {
+			t.Errorf("apply with array = %s", got)
+		}
+		if res, err = fn.Apply(nil, script(t, env, `({ length: 2, 0: "x", 1: "y" })`)); err != nil {
+			t.Fatal(err)
+		} else if got := describe(t, res); got != `{"this":"undefined","args":["x","y"]}` {
+			t.Errorf("apply with array like = %s", got)
+		}
+		if res, err = fn.Apply(nil, nil); err != nil {
+			t.Fatal(err)
+		} else if got := describe(t, res); got != `{"this":"undefined","args":[]}` {
+			t.Errorf("apply without arguments = %s", got)
+		}
+	})
+}
+
+func TestFunctionNameLength(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		fn, err := napi.CreateFunction(env, "created", func(ci *napi.CallbackInfo) (napi.ValueType, error) { return nil, nil })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name, err := fn.Name(); err != nil || name != "created" {
+			t.Errorf("Name() = %q, %v, want %q", name, err, "created")
+		}
+		if err = fn.SetName("renamed"); err != nil {
+			t.Fatal(err)
+		} else if err = fn.SetLength(3); err != nil {
+			t.Fatal(err)
+		}
+
+		// Same property descriptor of Javascript functions
+		const properties = `(fn) => ["name", "length"].map(name => {
+	const { value, writable, enumerable, configurable } = Object.getOwnPropertyDescriptor(fn, name);
+	return { value, writable, enumerable, configurable };
+})`
+		want := `[{"value":"renamed","writable":false,"enumerable":false,"configurable":true},{"value":3,"writable":false,"enumerable":false,"configurable":true}]`
+		if got := callDescribe(t, env, properties, fn); got != want {
+			t.Errorf("function properties = %s, want %s", got, want)
+		}
+		if name, err := fn.Name(); err != nil || name != "renamed" {
+			t.Errorf("Name() after SetName = %q, %v, want %q", name, err, "renamed")
+		}
+
+		// Go functions has length 0, all arguments can be omitted
+		goFn, err := napi.GoFuncOf(env, func(a string, b int) string { return a })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := callDescribe(t, env, `(fn) => fn.length`, goFn); got != "0" {
+			t.Errorf("GoFuncOf length = %s, want 0", got)
+		}
+	})
+}
@@ -0,0 +1,454 @@
+// Generate Go code to convert values and call functions without reflection, used by napi-go bind.
+//
+// Functions and structs with "//napi:bind" directive in doc comment are generated:
+//
+//	//napi:bind
+//	func Sum(a, b int) (int, error)
+//
+// generate "func SumCallback(ci *napi.CallbackInfo) (napi.ValueType, error)" decoding arguments same of napi.GoFuncOf,
+// and to structs the methods MarshalNapi and UnmarshalNapi.
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/types"
+	pathpkg "path"
+	"reflect"
+	"slices"
+	"strings"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/pkgload"
+)
+
+const (
+	napiPackage = "sirherobrine23.com.br/Sirherobrine23/napi-go"
+	bindPackage = napiPackage + "/bind"
+
+	// Directive to generate function or struct
+	Directive = "//napi:bind"
+
+	// First line of generated files
+	Header = "// Code generated by napi-go bind. DO NOT EDIT."
+)
+
+type generator struct {
+	pkg     *pkgload.Package
+	structs map[*types.TypeName]bool // Structs generated, implement Marshaler and Unmarshaler after generation
+	imports map[string]string        // Import path to name
+	body    bytes.Buffer
+}
+
+// Generate return Go source with callbacks and methods to declarations with [Directive] in package
+func Generate(pkg *pkgload.Package) ([]byte, error) {
+	g := &generator{
+		pkg:     pkg,
+		structs: map[*types.TypeName]bool{},
+		imports: map[string]string{napiPackage: "napi", bindPackage: "bind"},
+	}
+
+	var funcs []*types.Func
+	var structs []*types.TypeName
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil && hasDirective(decl.Doc) {
+					funcs = append(funcs, pkg.Info.Defs[decl.Name].(*types.Func))
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok && (hasDirective(spec.Doc) || (len(decl.Specs) == 1 && hasDirective(decl.Doc))) {
+						obj := pkg.Info.Defs[spec.Name].(*types.TypeName)
+						if _, ok := obj.Type().Underlying().(*types.Struct); !ok {
+							return nil, fmt.Errorf("%s: %s is not struct", pkg.Fset.Position(spec.Pos()), obj.Name())
+						} else if spec.TypeParams != nil {
+							return nil, fmt.Errorf("%s: generic struct %s not supported", pkg.Fset.Position(spec.Pos()), obj.Name())
+						}
+						structs = append(structs, obj)
+						g.structs[obj] = true
+					}
+				}
+			}
+		}
+	}
+	if len(funcs) == 0 && len(structs) == 0 {
+		return nil, fmt.Errorf("%s: no declarations with %s", pkg.ImportPath, Directive)
+	}
+
+	for _, fn := range funcs {
+		if err := g.function(fn); err != nil {
+			return nil, fmt.Errorf("%s: %w", pkg.Fset.Position(fn.Pos()), err)
+		}
+	}
+	for _, obj := range structs {
+		g.marshal(obj)
+		g.unmarshal(obj)
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "%s\n\npackage %s\n\nimport (\n", Header, pkg.Name)
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	for _, path := range paths {
+		if name := g.imports[path]; name != pathpkg.Base(path) {
+			fmt.Fprintf(&src, "\t%s %q\n", name, path)
+		} else {
+			fmt.Fprintf(&src, "\t%q\n", path)
+		}
+	}
+	src.WriteString(")\n")
+	src.Write(g.body.Bytes())
+
+	out, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w\n%s", err, src.Bytes())
+	}
+	return out, nil
+}
+
+func hasDirective(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	return slices.ContainsFunc(doc.List, func(comment *ast.Comment) bool {
+		return strings.TrimSpace(comment.Text) == Directive
+	})
+}
+
+// Qualifier to types.TypeString adding imports
+func (g *generator) qualifier(pkg *types.Package) string {
+	if pkg == g.pkg.Types {
+		return ""
+	} else if name, ok := g.imports[pkg.Path()]; ok {
+		return name
+	}
+
+	name := pkg.Name()
+	for count := 2; g.importUsed(name); count++ {
+		name = fmt.Sprintf("%s%d", pkg.Name(), count)
+	}
+	g.imports[pkg.Path()] = name
+	return name
+}
+
+func (g *generator) importUsed(name string) bool {
+	for _, used := range g.imports {
+		if used == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) typeString(typ types.Type) string {
+	return types.TypeString(typ, g.qualifier)
+}
+
+// Generate callback to function, functions with context.Context are called with napi.CallContext
+func (g *generator) function(fn *types.Func) error {
+	sig := fn.Type().(*types.Signature)
+	if sig.TypeParams() != nil {
+		return fmt.Errorf("generic function %s not supported", fn.Name())
+	}
+
+	params, results := sig.Params(), sig.Results()
+	returnError := results.Len() > 0 && isError(results.At(results.Len()-1).Type())
+	resultsLen := results.Len()
+	if returnError {
+		resultsLen--
+	}
+
+	fmt.Fprintf(&g.body, "\n// %sCallback is [napi.Callback] to call %s from Javascript.\n", fn.Name(), fn.Name())
+	fmt.Fprintf(&g.body, "func %sCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {\n", fn.Name())
+
+	// First parameter not converted from arguments, same of napi.GoFuncOf
+	var args []string
+	contextType, jsArgs := "", "ci.Args"
+	if params.Len() > 0 {
+		first := params.At(0).Type()
+		if ptr, ok := first.(*types.Pointer); ok && isNapiType(ptr.Elem(), "CallbackInfo") {
+			args = append(args, "ci")
+		} else if isNapiType(first, "This") {
+			args = append(args, "napi.This{Object: napi.ToObject(ci.This)}")
+		} else if isContext(first) {
+			contextType, jsArgs = g.typeString(first), "args"
+			args = append(args, "ctx")
+			fmt.Fprintf(&g.body, "return napi.CallContext(ci, %d, %t, func(args []napi.ValueType) (func(ctx %s) (napi.Marshaler, error), error) {\n", params.Len()-1, sig.Variadic(), contextType)
+		}
+	}
+
+	skip := len(args)
+	for index := skip; index < params.Len(); index++ {
+		arg := fmt.Sprintf("arg%d", index)
+		if sig.Variadic() && index == params.Len()-1 {
+			elem := params.At(index).Type().(*types.Slice).Elem()
+			fmt.Fprintf(&g.body, "%s, err := bind.Variadic(%s, %d, %s)\n", arg, jsArgs, index-skip, g.decoder(elem))
+			arg += "..."
+		} else {
+			typ := params.At(index).Type()
+			fmt.Fprintf(&g.body, "%s, err := bind.Arg(%s, %d, %s)\n", arg, jsArgs, index-skip, g.decoder(typ))
+		}
+		g.body.WriteString("if err != nil {\nreturn nil, err\n}\n")
+		args = append(args, arg)
+	}
+	if contextType != "" {
+		fmt.Fprintf(&g.body, "return func(ctx %s) (napi.Marshaler, error) {\n", contextType)
+	}
+
+	var res []string
+	for index := range resultsLen {
+		res = append(res, fmt.Sprintf("res%d", index))
+	}
+	call := fmt.Sprintf("%s(%s)", fn.Name(), strings.Join(args, ", "))
+	switch {
+	case returnError && resultsLen == 0:
+		fmt.Fprintf(&g.body, "if err := %s; err != nil {\nreturn nil, err\n}\n", call)
+	case returnError:
+		fmt.Fprintf(&g.body, "%s, err := %s\nif err != nil {\nreturn nil, err\n}\n", strings.Join(res, ", "), call)
+	case resultsLen > 0:
+		fmt.Fprintf(&g.body, "%s := %s\n", strings.Join(res, ", "), call)
+	default:
+		fmt.Fprintf(&g.body, "%s\n", call)
+	}
+
+	switch {
+	case contextType == "":
+		g.results(results, resultsLen, "ci.Env")
+	case resultsLen == 0:
+		g.body.WriteString("return nil, nil\n}, nil\n})\n")
+	default: // Results are converted in Javascript thread
+		g.body.WriteString("return bind.MarshalFunc(func(env napi.EnvType) (napi.ValueType, error) {\n")
+		g.results(results, resultsLen, "env")
+		g.body.WriteString("}), nil\n}, nil\n})\n")
+	}
+	g.body.WriteString("}\n")
+	return nil
+}
+
+// Generate return of results converted to Javascript with env, multiple results as array
+func (g *generator) results(results *types.Tuple, resultsLen int, env string) {
+	switch resultsLen {
+	case 0:
+		fmt.Fprintf(&g.body, "return %s.Undefined()\n", env)
+	case 1:
+		fmt.Fprintf(&g.body, "return %s(%s, res0)\n", g.encoder(results.At(0).Type()), env)
+	default:
+		var values []string
+		for index := range resultsLen {
+			fmt.Fprintf(&g.body, "value%d, err := %s(%s, res%d)\nif err != nil {\nreturn nil, err\n}\n", index, g.encoder(results.At(index).Type()), env, index)
+			values = append(values, fmt.Sprintf("value%d", index))
+		}
+		fmt.Fprintf(&g.body, "return bind.Tuple(%s, %s)\n", env, strings.Join(values, ", "))
+	}
+}
+
+// Generate MarshalNapi to struct
+func (g *generator) marshal(obj *types.TypeName) {
+	fmt.Fprintf(&g.body, "\n// MarshalNapi convert %s to Javascript object.\n", obj.Name())
+	fmt.Fprintf(&g.body, "func (v %s) MarshalNapi(env napi.EnvType) (napi.ValueType, error) {\n", obj.Name())
+	g.body.WriteString("obj, err := napi.CreateObject(env)\nif err != nil {\nreturn nil, err\n}\n")
+	for _, field := range structFields(obj.Type().Underlying().(*types.Struct)) {
+		fmt.Fprintf(&g.body, "if value, err := %s(env, v.%s); err != nil {\nreturn nil, err\n}", g.encoder(field.Type), field.GoName)
+		fmt.Fprintf(&g.body, " else if err = bind.SetField(obj, %q, %q, value); err != nil {\nreturn nil, err\n}\n", field.Name, field.Option)
+	}
+	g.body.WriteString("return obj, nil\n}\n")
+}
+
+// Generate UnmarshalNapi to struct
+func (g *generator) unmarshal(obj *types.TypeName) {
+	fmt.Fprintf(&g.body, "\n// UnmarshalNapi decode Javascript object to %s.\n", obj.Name())
+	fmt.Fprintf(&g.body, "func (v *%s) UnmarshalNapi(value napi.ValueType) error {\n", obj.Name())
+	g.body.WriteString("obj, err := bind.DecodeObject(value, \"struct\")\nif err != nil {\nreturn err\n}\n")
+	fmt.Fprintf(&g.body, "*v = %s{}\n", obj.Name())
+	for _, field := range structFields(obj.Type().Underlying().(*types.Struct)) {
+		fmt.Fprintf(&g.body, "if field, ok, err := bind.Field(obj, %q); err != nil {\nreturn err\n} else if ok {\n", field.Name)
+		fmt.Fprintf(&g.body, "if v.%s, err = %s(field); err != nil {\nreturn err\n}\n}\n", field.GoName, g.decoder(field.Type))
+	}
+	g.body.WriteString("return nil\n}\n")
+}
+
+type structField struct {
+	GoName, Name, Option string
+	Type                 types.Type
+}
+
+// Return struct fields with same rules of napi.ValueOf
+func structFields(st *types.Struct) (fields []structField) {
+	for index := range st.NumFields() {
+		field := st.Field(index)
+		tag := strings.TrimSpace(reflect.StructTag(st.Tag(index)).Get("napi"))
+		if !field.Exported() || tag == "-" {
+			continue
+		}
+		name, option, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name()
+		}
+		fields = append(fields, structField{field.Name(), name, option, field.Type()})
+	}
+	return
+}
+
+// Return Go expression of bind.Encoder to type
+func (g *generator) encoder(typ types.Type) string {
+	if ptr, ok := typ.(*types.Pointer); ok && !g.isNapiValue(typ) {
+		return "bind.EncodePointer(" + g.encoder(ptr.Elem()) + ")"
+	}
+
+	switch {
+	case g.isGenerated(typ) || implements(typ, "MarshalNapi"):
+		return "bind.EncodeMarshaler[" + g.typeString(typ) + "]"
+	case g.isNapiValue(typ):
+		return "bind.EncodeValue[" + g.typeString(typ) + "]"
+	case isTime(typ):
+		return "bind.EncodeTime"
+	case isNamed(typ) && (implements(typ, "MarshalText") || implements(typ, "MarshalJSON")):
+		return "bind.EncodeAny[" + g.typeString(typ) + "]"
+	}
+
+	switch under := typ.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case under.Kind() == types.Int64:
+			return "bind.EncodeInt64[" + g.typeString(typ) + "]"
+		case under.Kind() == types.Uint64:
+			return "bind.EncodeUint64[" + g.typeString(typ) + "]"
+		case under.Kind() == types.Uintptr || under.Info()&types.IsComplex != 0:
+		case under.Info()&types.IsNumeric != 0:
+			return "bind.EncodeNumber[" + g.typeString(typ) + "]"
+		case under.Info()&types.IsString != 0:
+			return "bind.EncodeString[" + g.typeString(typ) + "]"
+		case under.Info()&types.IsBoolean != 0:
+			return "bind.EncodeBool[" + g.typeString(typ) + "]"
+		}
+	case *types.Slice:
+		if isNamed(typ) {
+			break
+		} else if types.Identical(under.Elem(), types.Typ[types.Byte]) {
+			return "bind.EncodeBytes"
+		}
+		return "bind.EncodeSlice(" + g.encoder(under.Elem()) + ")"
+	case *types.Map:
+		if isNamed(typ) || !isString(under.Key()) {
+			break
+		}
+		return fmt.Sprintf("bind.EncodeMap[%s, %s](%s)", g.typeString(under.Key()), g.typeString(under.Elem()), g.encoder(under.Elem()))
+	}
+	return "bind.EncodeAny[" + g.typeString(typ) + "]"
+}
+
+// Return Go expression of bind.Decoder to type, undefined and null are nil to pointers same of napi.ValueFrom
+func (g *generator) decoder(typ types.Type) string {
+	if ptr, ok := typ.(*types.Pointer); ok && !g.isNapiValue(typ) {
+		return "bind.DecodeNullable(bind.DecodePointer(" + g.decoder(ptr.Elem()) + "))"
+	}
+
+	switch {
+	case g.isGenerated(typ) || implements(types.NewPointer(typ), "UnmarshalNapi"):
+		return "bind.DecodeUnmarshaler[" + g.typeString(typ) + "]"
+	case g.isNapiValue(typ):
+		return "bind.DecodeAny[" + g.typeString(typ) + "]"
+	case isTime(typ):
+		return "bind.DecodeTime"
+	case isNamed(typ) && (implements(types.NewPointer(typ), "UnmarshalText") || implements(types.NewPointer(typ), "UnmarshalJSON")):
+		return "bind.DecodeAny[" + g.typeString(typ) + "]"
+	}
+
+	switch under := typ.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case under.Kind() == types.Uintptr || under.Info()&types.IsComplex != 0:
+		case under.Info()&types.IsInteger != 0 && under.Info()&types.IsUnsigned != 0:
+			return "bind.DecodeUint[" + g.typeString(typ) + "]"
+		case under.Info()&types.IsInteger != 0:
+			return "bind.DecodeInt[" + g.typeString(typ) + "]"
+		case under.Info()&types.IsFloat != 0:
+			return "bind.DecodeFloat[" + g.typeString(typ) + "]"
+		case under.Info()&types.IsString != 0:
+			return "bind.DecodeString[" + g.typeString(typ) + "]"
+		case under.Info()&types.IsBoolean != 0:
+			return "bind.DecodeBool[" + g.typeString(typ) + "]"
+		}
+	case *types.Slice:
+		if isNamed(typ) {
+			break
+		} else if types.Identical(under.Elem(), types.Typ[types.Byte]) {
+			return "bind.DecodeBytes"
+		}
+		return "bind.DecodeSlice(" + g.decoder(under.Elem()) + ")"
+	case *types.Map:
+		if isNamed(typ) || !isString(under.Key()) {
+			break
+		}
+		return fmt.Sprintf("bind.DecodeMap[%s, %s](%s)", g.typeString(under.Key()), g.typeString(under.Elem()), g.decoder(under.Elem()))
+	}
+	return "bind.DecodeAny[" + g.typeString(typ) + "]"
+}
+
+// Check if type is struct generated in this run
+func (g *generator) isGenerated(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	return ok && g.structs[named.Obj()]
+}
+
+func isNamed(typ types.Type) bool {
+	_, ok := types.Unalias(typ).(*types.Named)
+	return ok
+}
+
+func isContext(typ types.Type) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "context" && named.Obj().Name() == "Context"
+}
+
+// Check if type is declared in napi package with name
+func isNapiType(typ types.Type, name string) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == napiPackage && named.Obj().Name() == name
+}
+
+func isString(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
+
+func isTime(typ types.Type) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time"
+}
+
+// Check if type is error interface or pointer implementing error
+func isError(typ types.Type) bool {
+	errorType := types.Universe.Lookup("error").Type()
+	if types.Identical(typ, errorType) {
+		return true
+	}
+	_, isPointer := typ.Underlying().(*types.Pointer)
+	_, isInterface := typ.Underlying().(*types.Interface)
+	return (isPointer || isInterface) && types.Implements(typ, errorType.Underlying().(*types.Interface))
+}
+
+// Check if type is napi.ValueType or implement it, example *napi.String
+func (g *generator) isNapiValue(typ types.Type) bool {
+	for _, pkg := range g.pkg.Types.Imports() {
+		if pkg.Path() != napiPackage {
+			continue
+		} else if obj, ok := pkg.Scope().Lookup("ValueType").(*types.TypeName); ok {
+			return types.Implements(typ, obj.Type().Underlying().(*types.Interface))
+		}
+	}
+	return false
+}
+
+// Check if method is declared to type
+func implements(typ types.Type, method string) bool {
+	obj, _, _ := types.LookupFieldOrMethod(typ, true, nil, method)
+	_, ok := obj.(*types.Func)
+	return ok
+}
@@ -0,0 +1,73 @@
+package bindgen_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/bindgen"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/pkgload"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+func TestGenerateGolden(t *testing.T) {
+	pkg, err := pkgload.Load(".", "./testdata/bindtest", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := bindgen.Generate(pkg)
+	if err != nil {
+		t.Fatal(err)
+	} else if !bytes.HasPrefix(got, []byte(bindgen.Header+"\n")) {
+		t.Errorf("generated code not start with %q", bindgen.Header)
+	}
+
+	golden := filepath.Join("testdata", "bindtest", "napi_bind.go")
+	if *update {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(got, want) {
+		t.Errorf("generated code differ from %s, run go test -update:\n%s", golden, got)
+	}
+}
+
+func TestGenerateError(t *testing.T) {
+	tests := []struct {
+		name, source, err string
+	}{
+		{"no directive", "package p\n\nfunc Sum(a, b int) int { return a + b }\n", "no declarations with //napi:bind"},
+		{"not struct", "package p\n\n//napi:bind\ntype Level int\n", "Level is not struct"},
+		{"generic struct", "package p\n\n//napi:bind\ntype Box[T any] struct{ Value T }\n", "generic struct Box not supported"},
+		{"generic function", "package p\n\n//napi:bind\nfunc Identity[T any](v T) T { return v }\n", "generic function Identity not supported"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			files := map[string]string{
+				"go.mod": "module example.com/p\n\ngo 1.24\n",
+				"p.go":   test.source,
+			}
+			for name, data := range files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			pkg, err := pkgload.Load(dir, ".", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := bindgen.Generate(pkg); err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("Generate error = %v, want %q", err, test.err)
+			}
+		})
+	}
+}
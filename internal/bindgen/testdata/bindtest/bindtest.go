@@ -0,0 +1,153 @@
+// Package bindtest is used by golden test of napi-go bind and by parity test of
+// generated callbacks with napi.GoFuncOf, napi_bind.go is generated by go test -update.
+package bindtest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+)
+
+//napi:bind
+type Address struct {
+	Street string `napi:"street"`
+	Number int    `napi:"number,omitempty"`
+}
+
+//napi:bind
+type User struct {
+	Name     string            `napi:"name"`
+	Age      uint8             `napi:"age,omitzero"`
+	Email    *string           `napi:"email"`
+	Address  *Address          `napi:"address"`
+	Tags     []string          `napi:"tags"`
+	Avatar   []byte            `napi:"avatar"`
+	Balance  int64             `napi:"balance"`
+	Level    Level             `napi:"level"`
+	Color    Color             `napi:"color"`
+	Created  time.Time         `napi:"created"`
+	Metadata map[string]string `napi:"metadata"`
+	Friends  []*Address        `napi:"friends"`
+	Skipped  string            `napi:"-"`
+	internal string
+}
+
+// Named number type
+type Level int
+
+// Text marshaled type
+type Color struct{ R, G, B uint8 }
+
+func (c Color) MarshalText() ([]byte, error) {
+	return []byte{'#', hex[c.R>>4], hex[c.R&15], hex[c.G>>4], hex[c.G&15], hex[c.B>>4], hex[c.B&15]}, nil
+}
+
+func (c *Color) UnmarshalText(text []byte) error {
+	if len(text) != 7 || text[0] != '#' {
+		return errors.New("invalid color")
+	}
+	for index, ptr := range []*uint8{&c.R, &c.G, &c.B} {
+		hi, lo := strings.IndexByte(hex, text[1+index*2]), strings.IndexByte(hex, text[2+index*2])
+		if hi < 0 || lo < 0 {
+			return errors.New("invalid color")
+		}
+		*ptr = uint8(hi<<4 | lo)
+	}
+	return nil
+}
+
+const hex = "0123456789abcdef"
+
+//napi:bind
+func Numbers(a int, b int8, c uint16, d uint32, e float32, f float64) float64 {
+	return float64(a) + float64(b) + float64(c) + float64(d) + float64(e) + f
+}
+
+//napi:bind
+func Big(a int64, b uint64) (int64, uint64) { return a * 2, b / 2 }
+
+//napi:bind
+func Greet(name string, greeting *string, times napi.Optional[int]) string {
+	prefix := "Hello"
+	if greeting != nil {
+		prefix = *greeting
+	}
+	return strings.Repeat(prefix+", "+name+"! ", times.Or(1))
+}
+
+//napi:bind
+func Sum(first int, numbers ...float64) float64 {
+	sum := float64(first)
+	for _, n := range numbers {
+		sum += n
+	}
+	return sum
+}
+
+//napi:bind
+func Reverse(data []byte, flags map[string]bool) ([]byte, map[string]bool) {
+	out := make([]byte, len(data))
+	for index, b := range data {
+		out[len(data)-1-index] = b
+	}
+	return out, flags
+}
+
+//napi:bind
+func Rename(user User, name string) (*User, error) {
+	if name == "" {
+		return nil, errors.New("empty name")
+	}
+	user.Name = name
+	return &user, nil
+}
+
+//napi:bind
+func Validate(user *User) error {
+	if user == nil || user.Name == "" {
+		return errors.New("user without name")
+	}
+	return nil
+}
+
+//napi:bind
+func Tomorrow(date time.Time, color Color, level Level) (time.Time, Color, Level) {
+	return date.AddDate(0, 0, 1), Color{color.B, color.G, color.R}, level + 1
+}
+
+//napi:bind
+func Describe(this napi.This, value napi.ValueType) (string, error) {
+	typeOf, err := value.Type()
+	if err != nil {
+		return "", err
+	}
+	name, err := this.Get("name")
+	if err != nil {
+		return "", err
+	}
+	var str string
+	err = napi.ValueFrom(name, &str)
+	return str + ": " + typeOf.String(), err
+}
+
+//napi:bind
+func Count(ci *napi.CallbackInfo, items []string) int { return len(ci.Args) + len(items) }
+
+//napi:bind
+func Nothing() {}
+
+//napi:bind
+func Wait(ctx context.Context, ms int) (string, error) {
+	select {
+	case <-time.After(time.Duration(ms) * time.Millisecond):
+		return "done", nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+//napi:bind
+func Fetch(ctx context.Context, urls ...string) error { return ctx.Err() }
@@ -0,0 +1,444 @@
+// Code generated by napi-go bind. DO NOT EDIT.
+
+package bindtest
+
+import (
+	"context"
+	napi "sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/bind"
+)
+
+// NumbersCallback is [napi.Callback] to call Numbers from Javascript.
+func NumbersCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	arg0, err := bind.Arg(ci.Args, 0, bind.DecodeInt[int])
+	if err != nil {
+		return nil, err
+	}
+	arg1, err := bind.Arg(ci.Args, 1, bind.DecodeInt[int8])
+	if err != nil {
+		return nil, err
+	}
+	arg2, err := bind.Arg(ci.Args, 2, bind.DecodeUint[uint16])
+	if err != nil {
+		return nil, err
+	}
+	arg3, err := bind.Arg(ci.Args, 3, bind.DecodeUint[uint32])
+	if err != nil {
+		return nil, err
+	}
+	arg4, err := bind.Arg(ci.Args, 4, bind.DecodeFloat[float32])
+	if err != nil {
+		return nil, err
+	}
+	arg5, err := bind.Arg(ci.Args, 5, bind.DecodeFloat[float64])
+	if err != nil {
+		return nil, err
+	}
+	res0 := Numbers(arg0, arg1, arg2, arg3, arg4, arg5)
+	return bind.EncodeNumber[float64](ci.Env, res0)
+}
+
+// BigCallback is [napi.Callback] to call Big from Javascript.
+func BigCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	arg0, err := bind.Arg(ci.Args, 0, bind.DecodeInt[int64])
+	if err != nil {
+		return nil, err
+	}
+	arg1, err := bind.Arg(ci.Args, 1, bind.DecodeUint[uint64])
+	if err != nil {
+		return nil, err
+	}
+	res0, res1 := Big(arg0, arg1)
+	value0, err := bind.EncodeInt64[int64](ci.Env, res0)
+	if err != nil {
+		return nil, err
+	}
+	value1, err := bind.EncodeUint64[uint64](ci.Env, res1)
+	if err != nil {
+		return nil, err
+	}
+	return bind.Tuple(ci.Env, value0, value1)
+}
+
+// GreetCallback is [napi.Callback] to call Greet from Javascript.
+func GreetCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	arg0, err := bind.Arg(ci.Args, 0, bind.DecodeString[string])
+	if err != nil {
+		return nil, err
+	}
+	arg1, err := bind.Arg(ci.Args, 1, bind.DecodeNullable(bind.DecodePointer(bind.DecodeString[string])))
+	if err != nil {
+		return nil, err
+	}
+	arg2, err := bind.Arg(ci.Args, 2, bind.DecodeUnmarshaler[napi.Optional[int]])
+	if err != nil {
+		return nil, err
+	}
+	res0 := Greet(arg0, arg1, arg2)
+	return bind.EncodeString[string](ci.Env, res0)
+}
+
+// SumCallback is [napi.Callback] to call Sum from Javascript.
+func SumCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	arg0, err := bind.Arg(ci.Args, 0, bind.DecodeInt[int])
+	if err != nil {
+		return nil, err
+	}
+	arg1, err := bind.Variadic(ci.Args, 1, bind.DecodeFloat[float64])
+	if err != nil {
+		return nil, err
+	}
+	res0 := Sum(arg0, arg1...)
+	return bind.EncodeNumber[float64](ci.Env, res0)
+}
+
+// ReverseCallback is [napi.Callback] to call Reverse from Javascript.
+func ReverseCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	arg0, err := bind.Arg(ci.Args, 0, bind.DecodeBytes)
+	if err != nil {
+		return nil, err
+	}
+	arg1, err := bind.Arg(ci.Args, 1, bind.DecodeMap[string, bool](bind.DecodeBool[bool]))
+	if err != nil {
+		return nil, err
+	}
+	res0, res1 := Reverse(arg0, arg1)
+	value0, err := bind.EncodeBytes(ci.Env, res0)
+	if err != nil {
+		return nil, err
+	}
+	value1, err := bind.EncodeMap[string, bool](bind.EncodeBool[bool])(ci.Env, res1)
+	if err != nil {
+		return nil, err
+	}
+	return bind.Tuple(ci.Env, value0, value1)
+}
+
+// RenameCallback is [napi.Callback] to call Rename from Javascript.
+func RenameCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	arg0, err := bind.Arg(ci.Args, 0, bind.DecodeUnmarshaler[User])
+	if err != nil {
+		return nil, err
+	}
+	arg1, err := bind.Arg(ci.Args, 1, bind.DecodeString[string])
+	if err != nil {
+		return nil, err
+	}
+	res0, err := Rename(arg0, arg1)
+	if err != nil {
+		return nil, err
+	}
+	return bind.EncodePointer(bind.EncodeMarshaler[User])(ci.Env, res0)
+}
+
+// ValidateCallback is [napi.Callback] to call Validate from Javascript.
+func ValidateCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	arg0, err := bind.Arg(ci.Args, 0, bind.DecodeNullable(bind.DecodePointer(bind.DecodeUnmarshaler[User])))
+	if err != nil {
+		return nil, err
+	}
+	if err := Validate(arg0); err != nil {
+		return nil, err
+	}
+	return ci.Env.Undefined()
+}
+
+// TomorrowCallback is [napi.Callback] to call Tomorrow from Javascript.
+func TomorrowCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	arg0, err := bind.Arg(ci.Args, 0, bind.DecodeTime)
+	if err != nil {
+		return nil, err
+	}
+	arg1, err := bind.Arg(ci.Args, 1, bind.DecodeAny[Color])
+	if err != nil {
+		return nil, err
+	}
+	arg2, err := bind.Arg(ci.Args, 2, bind.DecodeInt[Level])
+	if err != nil {
+		return nil, err
+	}
+	res0, res1, res2 := Tomorrow(arg0, arg1, arg2)
+	value0, err := bind.EncodeTime(ci.Env, res0)
+	if err != nil {
+		return nil, err
+	}
+	value1, err := bind.EncodeAny[Color](ci.Env, res1)
+	if err != nil {
+		return nil, err
+	}
+	value2, err := bind.EncodeNumber[Level](ci.Env, res2)
+	if err != nil {
+		return nil, err
+	}
+	return bind.Tuple(ci.Env, value0, value1, value2)
+}
+
+// DescribeCallback is [napi.Callback] to call Describe from Javascript.
+func DescribeCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	arg1, err := bind.Arg(ci.Args, 0, bind.DecodeAny[napi.ValueType])
+	if err != nil {
+		return nil, err
+	}
+	res0, err := Describe(napi.This{Object: napi.ToObject(ci.This)}, arg1)
+	if err != nil {
+		return nil, err
+	}
+	return bind.EncodeString[string](ci.Env, res0)
+}
+
+// CountCallback is [napi.Callback] to call Count from Javascript.
+func CountCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	arg1, err := bind.Arg(ci.Args, 0, bind.DecodeSlice(bind.DecodeString[string]))
+	if err != nil {
+		return nil, err
+	}
+	res0 := Count(ci, arg1)
+	return bind.EncodeNumber[int](ci.Env, res0)
+}
+
+// NothingCallback is [napi.Callback] to call Nothing from Javascript.
+func NothingCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	Nothing()
+	return ci.Env.Undefined()
+}
+
+// WaitCallback is [napi.Callback] to call Wait from Javascript.
+func WaitCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	return napi.CallContext(ci, 1, false, func(args []napi.ValueType) (func(ctx context.Context) (napi.Marshaler, error), error) {
+		arg1, err := bind.Arg(args, 0, bind.DecodeInt[int])
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) (napi.Marshaler, error) {
+			res0, err := Wait(ctx, arg1)
+			if err != nil {
+				return nil, err
+			}
+			return bind.MarshalFunc(func(env napi.EnvType) (napi.ValueType, error) {
+				return bind.EncodeString[string](env, res0)
+			}), nil
+		}, nil
+	})
+}
+
+// FetchCallback is [napi.Callback] to call Fetch from Javascript.
+func FetchCallback(ci *napi.CallbackInfo) (napi.ValueType, error) {
+	return napi.CallContext(ci, 1, true, func(args []napi.ValueType) (func(ctx context.Context) (napi.Marshaler, error), error) {
+		arg1, err := bind.Variadic(args, 0, bind.DecodeString[string])
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) (napi.Marshaler, error) {
+			if err := Fetch(ctx, arg1...); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		}, nil
+	})
+}
+
+// MarshalNapi convert Address to Javascript object.
+func (v Address) MarshalNapi(env napi.EnvType) (napi.ValueType, error) {
+	obj, err := napi.CreateObject(env)
+	if err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeString[string](env, v.Street); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "street", "", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeNumber[int](env, v.Number); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "number", "omitempty", value); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// UnmarshalNapi decode Javascript object to Address.
+func (v *Address) UnmarshalNapi(value napi.ValueType) error {
+	obj, err := bind.DecodeObject(value, "struct")
+	if err != nil {
+		return err
+	}
+	*v = Address{}
+	if field, ok, err := bind.Field(obj, "street"); err != nil {
+		return err
+	} else if ok {
+		if v.Street, err = bind.DecodeString[string](field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "number"); err != nil {
+		return err
+	} else if ok {
+		if v.Number, err = bind.DecodeInt[int](field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalNapi convert User to Javascript object.
+func (v User) MarshalNapi(env napi.EnvType) (napi.ValueType, error) {
+	obj, err := napi.CreateObject(env)
+	if err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeString[string](env, v.Name); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "name", "", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeNumber[uint8](env, v.Age); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "age", "omitzero", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodePointer(bind.EncodeString[string])(env, v.Email); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "email", "", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodePointer(bind.EncodeMarshaler[Address])(env, v.Address); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "address", "", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeSlice(bind.EncodeString[string])(env, v.Tags); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "tags", "", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeBytes(env, v.Avatar); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "avatar", "", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeInt64[int64](env, v.Balance); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "balance", "", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeNumber[Level](env, v.Level); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "level", "", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeAny[Color](env, v.Color); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "color", "", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeTime(env, v.Created); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "created", "", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeMap[string, string](bind.EncodeString[string])(env, v.Metadata); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "metadata", "", value); err != nil {
+		return nil, err
+	}
+	if value, err := bind.EncodeSlice(bind.EncodePointer(bind.EncodeMarshaler[Address]))(env, v.Friends); err != nil {
+		return nil, err
+	} else if err = bind.SetField(obj, "friends", "", value); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// UnmarshalNapi decode Javascript object to User.
+func (v *User) UnmarshalNapi(value napi.ValueType) error {
+	obj, err := bind.DecodeObject(value, "struct")
+	if err != nil {
+		return err
+	}
+	*v = User{}
+	if field, ok, err := bind.Field(obj, "name"); err != nil {
+		return err
+	} else if ok {
+		if v.Name, err = bind.DecodeString[string](field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "age"); err != nil {
+		return err
+	} else if ok {
+		if v.Age, err = bind.DecodeUint[uint8](field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "email"); err != nil {
+		return err
+	} else if ok {
+		if v.Email, err = bind.DecodeNullable(bind.DecodePointer(bind.DecodeString[string]))(field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "address"); err != nil {
+		return err
+	} else if ok {
+		if v.Address, err = bind.DecodeNullable(bind.DecodePointer(bind.DecodeUnmarshaler[Address]))(field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "tags"); err != nil {
+		return err
+	} else if ok {
+		if v.Tags, err = bind.DecodeSlice(bind.DecodeString[string])(field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "avatar"); err != nil {
+		return err
+	} else if ok {
+		if v.Avatar, err = bind.DecodeBytes(field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "balance"); err != nil {
+		return err
+	} else if ok {
+		if v.Balance, err = bind.DecodeInt[int64](field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "level"); err != nil {
+		return err
+	} else if ok {
+		if v.Level, err = bind.DecodeInt[Level](field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "color"); err != nil {
+		return err
+	} else if ok {
+		if v.Color, err = bind.DecodeAny[Color](field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "created"); err != nil {
+		return err
+	} else if ok {
+		if v.Created, err = bind.DecodeTime(field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "metadata"); err != nil {
+		return err
+	} else if ok {
+		if v.Metadata, err = bind.DecodeMap[string, string](bind.DecodeString[string])(field); err != nil {
+			return err
+		}
+	}
+	if field, ok, err := bind.Field(obj, "friends"); err != nil {
+		return err
+	} else if ok {
+		if v.Friends, err = bind.DecodeSlice(bind.DecodeNullable(bind.DecodePointer(bind.DecodeUnmarshaler[Address])))(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,724 @@
+package napi
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// Backend implement Node-API calls, default backend call Node-API with cgo,
+// tests can replace with [SetBackend] to run without Node.
+//
+// Functions of this package call current backend.
+type Backend interface {
+	CreateAsyncWork(env Env, asyncResource, asyncResourceName Value, execute AsyncExecuteCallback, complete AsyncCompleteCallback) (AsyncWork, Status)
+	DeleteAsyncWork(env Env, work AsyncWork) Status
+	QueueAsyncWork(env Env, work AsyncWork) Status
+	CancelAsyncWork(env Env, work AsyncWork) Status
+	InitializeInstanceData(env Env) Status
+	GetUndefined(env Env) (Value, Status)
+	GetNull(env Env) (Value, Status)
+	GetGlobal(env Env) (Value, Status)
+	GetBoolean(env Env, value bool) (Value, Status)
+	CreateObject(env Env) (Value, Status)
+	CreateArray(env Env) (Value, Status)
+	CreateArrayWithLength(env Env, length int) (Value, Status)
+	CreateDouble(env Env, value float64) (Value, Status)
+	CreateStringUtf8(env Env, str string) (Value, Status)
+	CreateSymbol(env Env, description Value) (Value, Status)
+	CreateFunction(env Env, name string, cb Callback) (Value, Status)
+	CreateError(env Env, code, msg Value) (Value, Status)
+	Typeof(env Env, value Value) (ValueType, Status)
+	GetValueDouble(env Env, value Value) (float64, Status)
+	GetValueBool(env Env, value Value) (bool, Status)
+	GetValueStringUtf8(env Env, value Value) (string, Status)
+	GetValueStringUtf16(env Env, value Value) ([]uint16, Status)
+	SetProperty(env Env, object, key, value Value) Status
+	SetElement(env Env, object Value, index int, value Value) Status
+	StrictEquals(env Env, lhs, rhs Value) (bool, Status)
+	GetCbInfo(env Env, info CallbackInfo) (GetCbInfoResult, Status)
+	Throw(env Env, err Value) Status
+	ThrowError(env Env, code, msg string) Status
+	SetInstanceData(env Env, data any) Status
+	GetInstanceData(env Env) (any, Status)
+	GetLibraryData(env Env) (*sync.Map, Status)
+	CreateExternal(env Env, data unsafe.Pointer, finalize Finalize, finalizeHint unsafe.Pointer) (Value, Status)
+	GetValueInt32(env Env, value Value) (int32, Status)
+	GetValueUint32(env Env, value Value) (uint32, Status)
+	GetValueInt64(env Env, value Value) (int64, Status)
+	GetValueBigIntInt64(env Env, value Value) (int64, bool, Status)
+	GetValueBigIntWords(env Env, value Value, signBit int, wordCount int, words *uint64) Status
+	GetValueExternal(env Env, value Value) (unsafe.Pointer, Status)
+	CoerceToBool(env Env, value Value) (Value, Status)
+	CoerceToNumber(env Env, value Value) (Value, Status)
+	CoerceToObject(env Env, value Value) (Value, Status)
+	CoerceToString(env Env, value Value) (Value, Status)
+	CreateBuffer(env Env, length int) (Value, Status)
+	CreateBufferCopy(env Env, data []byte) (Value, Status)
+	GetBufferInfo(env Env, value Value) (*byte, int, Status)
+	GetBufferInfoSize(env Env, value Value) (int, Status)
+	GetBufferInfoData(env Env, value Value) (buff []byte, status Status)
+	GetArrayLength(env Env, value Value) (int, Status)
+	GetPrototype(env Env, value Value) (Value, Status)
+	InstanceOf(env Env, object, constructor Value) (bool, Status)
+	IsArray(env Env, value Value) (bool, Status)
+	IsBuffer(env Env, value Value) (bool, Status)
+	IsError(env Env, value Value) (bool, Status)
+	IsPromise(env Env, value Value) (bool, Status)
+	IsTypedArray(env Env, value Value) (bool, Status)
+	GetTypedArrayInfo(env Env, value Value) (TypedArrayType, int, *byte, Value, int, Status)
+	CreateTypedArray(env Env, type_ TypedArrayType, length int, arrayBuffer Value, byteOffset int) (Value, Status)
+	AdjustExternalMemory(env Env, change int64) (int64, Status)
+	CreateDataView(env Env, length int, arrayBuffer Value, byteOffset int) (Value, Status)
+	GetDataViewInfo(env Env, value Value) (int, *byte, Value, int, Status)
+	GetAllPropertyNames(env Env, object Value, keyMode KeyCollectionMode, keyFilter KeyFilter, keyConversion KeyConversion) (Value, Status)
+	HasOwnProperty(env Env, object, key Value) (bool, Status)
+	HasProperty(env Env, object, key Value) (bool, Status)
+	GetPropertyNames(env Env, object Value) (Value, Status)
+	DefineProperties(env Env, object Value, properties []PropertyDescriptor) Status
+	GetValueBigIntUint64(env Env, value Value) (uint64, bool, Status)
+	CreateBigIntInt64(env Env, value int64) (Value, Status)
+	CreateBigIntUint64(env Env, value uint64) (Value, Status)
+	CreateBigIntWords(env Env, signBit int, wordCount int, words *uint64) (Value, Status)
+	IsDate(env Env, value Value) (bool, Status)
+	IsDetachedArrayBuffer(env Env, value Value) (bool, Status)
+	DetachArrayBuffer(env Env, value Value) Status
+	CreateArrayBuffer(env Env, length int) (Value, *byte, Status)
+	GetArrayBufferInfo(env Env, value Value) (*byte, int, Status)
+	CreateExternalArrayBuffer(env Env, data unsafe.Pointer, length int, finalize Finalize, finalizeHint unsafe.Pointer) (Value, Status)
+	GetElement(env Env, object Value, index int) (Value, Status)
+	GetProperty(env Env, object, key Value) (Value, Status)
+	DeleteProperty(env Env, object, key Value) (bool, Status)
+	SetNamedProperty(env Env, object Value, name string, value Value) Status
+	GetNamedProperty(env Env, object Value, name string) (Value, Status)
+	HasNamedProperty(env Env, object Value, name string) (bool, Status)
+	HasElement(env Env, object Value, index int) (bool, Status)
+	DeleteElement(env Env, object Value, index int) (bool, Status)
+	ObjectFreeze(env Env, object Value) Status
+	ObjectSeal(env Env, object Value) Status
+	ThrowTypeError(env Env, code, msg string) Status
+	ThrowRangeError(env Env, code, msg string) Status
+	CreateTypeError(env Env, code, msg Value) (Value, Status)
+	CreateRangeError(env Env, code, msg Value) (Value, Status)
+	IsExceptionPending(env Env) (bool, Status)
+	GetAndClearLastException(env Env) (Value, Status)
+	CloseCallbackScope(env Env, scope CallbackScope) Status
+	CreateInt32(env Env, value int32) (Value, Status)
+	CreateUint32(env Env, value uint32) (Value, Status)
+	CreateInt64(env Env, value int64) (Value, Status)
+	CreateStringLatin1(env Env, str string) (Value, Status)
+	CreateStringUtf16(env Env, str []uint16) (Value, Status)
+	CallFunction(env Env, recv Value, fn Value, argc int, argv []Value) (Value, Status)
+	RunScript(env Env, script Value) (Value, Status)
+	GetNewTarget(env Env, info CallbackInfo) (Value, Status)
+	NewInstance(env Env, constructor Value, argc int, argv []Value) (Value, Status)
+	IsDataView(env Env, value Value) (bool, Status)
+	IsArrayBuffer(env Env, value Value) (bool, Status)
+	GetDateValue(env Env, value Value) (float64, Status)
+	CreateDate(env Env, time float64) (Value, Status)
+	CreatePropertyKeyLatin1(env Env, str string) (Value, Status)
+	CreatePropertyKeyUtf16(env Env, str []uint16) (Value, Status)
+	CreatePropertyKeyUtf8(env Env, str string) (Value, Status)
+	GetNodeVersion(env Env) (NodeVersion, Status)
+	GetVersion(env Env) (uint32, Status)
+	GetModuleFileName(env Env) (string, Status)
+	ThrowSyntaxError(env Env, code, msg string) Status
+	CreateSyntaxError(env Env, code, msg Value) (Value, Status)
+	SymbolFor(env Env, description string) (Value, Status)
+	CreatePromise(env Env) (Value, Deferred, Status)
+	ResolveDeferred(env Env, deferred Deferred, resolution Value) Status
+	RejectDeferred(env Env, deferred Deferred, rejection Value) Status
+	GetExtendedErrorInfo(env Env) (*ExtendedError, Status)
+	CreateThreadsafeFunction(env Env, fn, asyncResource, asyncResourceName Value, maxQueueSize, initialThreadCount int, finalize ThreadsafeFunctionFinalize, callJS ThreadsafeFunctionCallJS) (ThreadsafeFunction, Status)
+	CallThreadsafeFunction(fn ThreadsafeFunction, data any, mode ThreadsafeFunctionCallMode) Status
+	AcquireThreadsafeFunction(fn ThreadsafeFunction) Status
+	ReleaseThreadsafeFunction(fn ThreadsafeFunction, mode ThreadsafeFunctionReleaseMode) Status
+	GetThreadsafeFunctionContext(fn ThreadsafeFunction) (unsafe.Pointer, Status)
+	RefThreadsafeFunction(env Env, fn ThreadsafeFunction) Status
+	UnrefThreadsafeFunction(env Env, fn ThreadsafeFunction) Status
+	CreateReference(env Env, value Value, initialRefcount int) (Reference, Status)
+	DeleteReference(env Env, ref Reference) Status
+	ReferenceRef(env Env, ref Reference) (int, Status)
+	ReferenceUnref(env Env, ref Reference) (int, Status)
+	GetReferenceValue(env Env, ref Reference) (Value, Status)
+	Wrap(env Env, jsObject Value, nativeObject unsafe.Pointer, finalize Finalize, finalizeHint unsafe.Pointer) Status
+	Unwrap(env Env, jsObject Value) (unsafe.Pointer, Status)
+	RemoveWrap(env Env, jsObject Value) Status
+	OpenHandleScope(env Env) (HandleScope, Status)
+	CloseHandleScope(env Env, scope HandleScope) Status
+	OpenEscapableHandleScope(env Env) (EscapableHandleScope, Status)
+	CloseEscapableHandleScope(env Env, scope EscapableHandleScope) Status
+	EscapeHandle(env Env, scope EscapableHandleScope, escapee Value) (Value, Status)
+}
+
+var backend Backend = defaultBackend
+
+var runtimeVersion atomic.Uint32
+
+// Return Node-API version of the runtime, stored on module initialization, 0 if module not initialized.
+func RuntimeVersion() uint32 {
+	return runtimeVersion.Load()
+}
+
+// SetBackend replace backend of Node-API calls and return previous backend,
+// must be called before any call to Node-API.
+func SetBackend(b Backend) Backend {
+	previous := backend
+	backend = b
+	return previous
+}
+
+func CreateAsyncWork(env Env, asyncResource, asyncResourceName Value, execute AsyncExecuteCallback, complete AsyncCompleteCallback) (AsyncWork, Status) {
+	return backend.CreateAsyncWork(env, asyncResource, asyncResourceName, execute, complete)
+}
+
+func DeleteAsyncWork(env Env, work AsyncWork) Status {
+	return backend.DeleteAsyncWork(env, work)
+}
+
+func QueueAsyncWork(env Env, work AsyncWork) Status {
+	return backend.QueueAsyncWork(env, work)
+}
+
+func CancelAsyncWork(env Env, work AsyncWork) Status {
+	return backend.CancelAsyncWork(env, work)
+}
+
+func InitializeInstanceData(env Env) Status {
+	if version, status := GetVersion(env); status == StatusOK {
+		runtimeVersion.Store(version)
+	}
+	return backend.InitializeInstanceData(env)
+}
+
+func GetUndefined(env Env) (Value, Status) {
+	return backend.GetUndefined(env)
+}
+
+func GetNull(env Env) (Value, Status) {
+	return backend.GetNull(env)
+}
+
+func GetGlobal(env Env) (Value, Status) {
+	return backend.GetGlobal(env)
+}
+
+func GetBoolean(env Env, value bool) (Value, Status) {
+	return backend.GetBoolean(env, value)
+}
+
+func CreateObject(env Env) (Value, Status) {
+	return backend.CreateObject(env)
+}
+
+func CreateArray(env Env) (Value, Status) {
+	return backend.CreateArray(env)
+}
+
+func CreateArrayWithLength(env Env, length int) (Value, Status) {
+	return backend.CreateArrayWithLength(env, length)
+}
+
+func CreateDouble(env Env, value float64) (Value, Status) {
+	return backend.CreateDouble(env, value)
+}
+
+func CreateStringUtf8(env Env, str string) (Value, Status) {
+	return backend.CreateStringUtf8(env, str)
+}
+
+func CreateSymbol(env Env, description Value) (Value, Status) {
+	return backend.CreateSymbol(env, description)
+}
+
+func CreateFunction(env Env, name string, cb Callback) (Value, Status) {
+	return backend.CreateFunction(env, name, cb)
+}
+
+func CreateError(env Env, code, msg Value) (Value, Status) {
+	return backend.CreateError(env, code, msg)
+}
+
+func Typeof(env Env, value Value) (ValueType, Status) {
+	return backend.Typeof(env, value)
+}
+
+func GetValueDouble(env Env, value Value) (float64, Status) {
+	return backend.GetValueDouble(env, value)
+}
+
+func GetValueBool(env Env, value Value) (bool, Status) {
+	return backend.GetValueBool(env, value)
+}
+
+func GetValueStringUtf8(env Env, value Value) (string, Status) {
+	return backend.GetValueStringUtf8(env, value)
+}
+
+func GetValueStringUtf16(env Env, value Value) ([]uint16, Status) {
+	return backend.GetValueStringUtf16(env, value)
+}
+
+func SetProperty(env Env, object, key, value Value) Status {
+	return backend.SetProperty(env, object, key, value)
+}
+
+func SetElement(env Env, object Value, index int, value Value) Status {
+	return backend.SetElement(env, object, index, value)
+}
+
+func StrictEquals(env Env, lhs, rhs Value) (bool, Status) {
+	return backend.StrictEquals(env, lhs, rhs)
+}
+
+func GetCbInfo(env Env, info CallbackInfo) (GetCbInfoResult, Status) {
+	return backend.GetCbInfo(env, info)
+}
+
+func Throw(env Env, err Value) Status {
+	return backend.Throw(env, err)
+}
+
+func ThrowError(env Env, code, msg string) Status {
+	return backend.ThrowError(env, code, msg)
+}
+
+func SetInstanceData(env Env, data any) Status {
+	return backend.SetInstanceData(env, data)
+}
+
+func GetInstanceData(env Env) (any, Status) {
+	return backend.GetInstanceData(env)
+}
+
+func GetLibraryData(env Env) (*sync.Map, Status) {
+	return backend.GetLibraryData(env)
+}
+
+func CreateExternal(env Env, data unsafe.Pointer, finalize Finalize, finalizeHint unsafe.Pointer) (Value, Status) {
+	return backend.CreateExternal(env, data, finalize, finalizeHint)
+}
+
+func GetValueInt32(env Env, value Value) (int32, Status) {
+	return backend.GetValueInt32(env, value)
+}
+
+func GetValueUint32(env Env, value Value) (uint32, Status) {
+	return backend.GetValueUint32(env, value)
+}
+
+func GetValueInt64(env Env, value Value) (int64, Status) {
+	return backend.GetValueInt64(env, value)
+}
+
+func GetValueBigIntInt64(env Env, value Value) (int64, bool, Status) {
+	return backend.GetValueBigIntInt64(env, value)
+}
+
+func GetValueBigIntWords(env Env, value Value, signBit int, wordCount int, words *uint64) Status {
+	return backend.GetValueBigIntWords(env, value, signBit, wordCount, words)
+}
+
+func GetValueExternal(env Env, value Value) (unsafe.Pointer, Status) {
+	return backend.GetValueExternal(env, value)
+}
+
+func CoerceToBool(env Env, value Value) (Value, Status) {
+	return backend.CoerceToBool(env, value)
+}
+
+func CoerceToNumber(env Env, value Value) (Value, Status) {
+	return backend.CoerceToNumber(env, value)
+}
+
+func CoerceToObject(env Env, value Value) (Value, Status) {
+	return backend.CoerceToObject(env, value)
+}
+
+func CoerceToString(env Env, value Value) (Value, Status) {
+	return backend.CoerceToString(env, value)
+}
+
+func CreateBuffer(env Env, length int) (Value, Status) {
+	return backend.CreateBuffer(env, length)
+}
+
+func CreateBufferCopy(env Env, data []byte) (Value, Status) {
+	return backend.CreateBufferCopy(env, data)
+}
+
+func GetBufferInfo(env Env, value Value) (*byte, int, Status) {
+	return backend.GetBufferInfo(env, value)
+}
+
+func GetBufferInfoSize(env Env, value Value) (int, Status) {
+	return backend.GetBufferInfoSize(env, value)
+}
+
+func GetBufferInfoData(env Env, value Value) (buff []byte, status Status) {
+	return backend.GetBufferInfoData(env, value)
+}
+
+func GetArrayLength(env Env, value Value) (int, Status) {
+	return backend.GetArrayLength(env, value)
+}
+
+func GetPrototype(env Env, value Value) (Value, Status) {
+	return backend.GetPrototype(env, value)
+}
+
+func InstanceOf(env Env, object, constructor Value) (bool, Status) {
+	return backend.InstanceOf(env, object, constructor)
+}
+
+func IsArray(env Env, value Value) (bool, Status) {
+	return backend.IsArray(env, value)
+}
+
+func IsBuffer(env Env, value Value) (bool, Status) {
+	return backend.IsBuffer(env, value)
+}
+
+func IsError(env Env, value Value) (bool, Status) {
+	return backend.IsError(env, value)
+}
+
+func IsPromise(env Env, value Value) (bool, Status) {
+	return backend.IsPromise(env, value)
+}
+
+func IsTypedArray(env Env, value Value) (bool, Status) {
+	return backend.IsTypedArray(env, value)
+}
+
+func GetTypedArrayInfo(env Env, value Value) (TypedArrayType, int, *byte, Value, int, Status) {
+	return backend.GetTypedArrayInfo(env, value)
+}
+
+func CreateTypedArray(env Env, type_ TypedArrayType, length int, arrayBuffer Value, byteOffset int) (Value, Status) {
+	return backend.CreateTypedArray(env, type_, length, arrayBuffer, byteOffset)
+}
+
+func AdjustExternalMemory(env Env, change int64) (int64, Status) {
+	return backend.AdjustExternalMemory(env, change)
+}
+
+func CreateDataView(env Env, length int, arrayBuffer Value, byteOffset int) (Value, Status) {
+	return backend.CreateDataView(env, length, arrayBuffer, byteOffset)
+}
+
+func GetDataViewInfo(env Env, value Value) (int, *byte, Value, int, Status) {
+	return backend.GetDataViewInfo(env, value)
+}
+
+func GetAllPropertyNames(env Env, object Value, keyMode KeyCollectionMode, keyFilter KeyFilter, keyConversion KeyConversion) (Value, Status) {
+	return backend.GetAllPropertyNames(env, object, keyMode, keyFilter, keyConversion)
+}
+
+func HasOwnProperty(env Env, object, key Value) (bool, Status) {
+	return backend.HasOwnProperty(env, object, key)
+}
+
+func HasProperty(env Env, object, key Value) (bool, Status) {
+	return backend.HasProperty(env, object, key)
+}
+
+func GetPropertyNames(env Env, object Value) (Value, Status) {
+	return backend.GetPropertyNames(env, object)
+}
+
+func DefineProperties(env Env, object Value, properties []PropertyDescriptor) Status {
+	return backend.DefineProperties(env, object, properties)
+}
+
+func GetValueBigIntUint64(env Env, value Value) (uint64, bool, Status) {
+	return backend.GetValueBigIntUint64(env, value)
+}
+
+func CreateBigIntInt64(env Env, value int64) (Value, Status) {
+	return backend.CreateBigIntInt64(env, value)
+}
+
+func CreateBigIntUint64(env Env, value uint64) (Value, Status) {
+	return backend.CreateBigIntUint64(env, value)
+}
+
+func CreateBigIntWords(env Env, signBit int, wordCount int, words *uint64) (Value, Status) {
+	return backend.CreateBigIntWords(env, signBit, wordCount, words)
+}
+
+func IsDate(env Env, value Value) (bool, Status) {
+	return backend.IsDate(env, value)
+}
+
+func IsDetachedArrayBuffer(env Env, value Value) (bool, Status) {
+	return backend.IsDetachedArrayBuffer(env, value)
+}
+
+func DetachArrayBuffer(env Env, value Value) Status {
+	return backend.DetachArrayBuffer(env, value)
+}
+
+func CreateArrayBuffer(env Env, length int) (Value, *byte, Status) {
+	return backend.CreateArrayBuffer(env, length)
+}
+
+func GetArrayBufferInfo(env Env, value Value) (*byte, int, Status) {
+	return backend.GetArrayBufferInfo(env, value)
+}
+
+func CreateExternalArrayBuffer(env Env, data unsafe.Pointer, length int, finalize Finalize, finalizeHint unsafe.Pointer) (Value, Status) {
+	return backend.CreateExternalArrayBuffer(env, data, length, finalize, finalizeHint)
+}
+
+func GetElement(env Env, object Value, index int) (Value, Status) {
+	return backend.GetElement(env, object, index)
+}
+
+func GetProperty(env Env, object, key Value) (Value, Status) {
+	return backend.GetProperty(env, object, key)
+}
+
+func DeleteProperty(env Env, object, key Value) (bool, Status) {
+	return backend.DeleteProperty(env, object, key)
+}
+
+func SetNamedProperty(env Env, object Value, name string, value Value) Status {
+	return backend.SetNamedProperty(env, object, name, value)
+}
+
+func GetNamedProperty(env Env, object Value, name string) (Value, Status) {
+	return backend.GetNamedProperty(env, object, name)
+}
+
+func HasNamedProperty(env Env, object Value, name string) (bool, Status) {
+	return backend.HasNamedProperty(env, object, name)
+}
+
+func HasElement(env Env, object Value, index int) (bool, Status) {
+	return backend.HasElement(env, object, index)
+}
+
+func DeleteElement(env Env, object Value, index int) (bool, Status) {
+	return backend.DeleteElement(env, object, index)
+}
+
+func ObjectFreeze(env Env, object Value) Status {
+	return backend.ObjectFreeze(env, object)
+}
+
+func ObjectSeal(env Env, object Value) Status {
+	return backend.ObjectSeal(env, object)
+}
+
+func ThrowTypeError(env Env, code, msg string) Status {
+	return backend.ThrowTypeError(env, code, msg)
+}
+
+func ThrowRangeError(env Env, code, msg string) Status {
+	return backend.ThrowRangeError(env, code, msg)
+}
+
+func CreateTypeError(env Env, code, msg Value) (Value, Status) {
+	return backend.CreateTypeError(env, code, msg)
+}
+
+func CreateRangeError(env Env, code, msg Value) (Value, Status) {
+	return backend.CreateRangeError(env, code, msg)
+}
+
+func IsExceptionPending(env Env) (bool, Status) {
+	return backend.IsExceptionPending(env)
+}
+
+func GetAndClearLastException(env Env) (Value, Status) {
+	return backend.GetAndClearLastException(env)
+}
+
+func CloseCallbackScope(env Env, scope CallbackScope) Status {
+	return backend.CloseCallbackScope(env, scope)
+}
+
+func CreateInt32(env Env, value int32) (Value, Status) {
+	return backend.CreateInt32(env, value)
+}
+
+func CreateUint32(env Env, value uint32) (Value, Status) {
+	return backend.CreateUint32(env, value)
+}
+
+func CreateInt64(env Env, value int64) (Value, Status) {
+	return backend.CreateInt64(env, value)
+}
+
+func CreateStringLatin1(env Env, str string) (Value, Status) {
+	return backend.CreateStringLatin1(env, str)
+}
+
+func CreateStringUtf16(env Env, str []uint16) (Value, Status) {
+	return backend.CreateStringUtf16(env, str)
+}
+
+func CallFunction(env Env, recv Value, fn Value, argc int, argv []Value) (Value, Status) {
+	return backend.CallFunction(env, recv, fn, argc, argv)
+}
+
+func RunScript(env Env, script Value) (Value, Status) {
+	return backend.RunScript(env, script)
+}
+
+func GetNewTarget(env Env, info CallbackInfo) (Value, Status) {
+	return backend.GetNewTarget(env, info)
+}
+
+func NewInstance(env Env, constructor Value, argc int, argv []Value) (Value, Status) {
+	return backend.NewInstance(env, constructor, argc, argv)
+}
+
+func IsDataView(env Env, value Value) (bool, Status) {
+	return backend.IsDataView(env, value)
+}
+
+func IsArrayBuffer(env Env, value Value) (bool, Status) {
+	return backend.IsArrayBuffer(env, value)
+}
+
+func GetDateValue(env Env, value Value) (float64, Status) {
+	return backend.GetDateValue(env, value)
+}
+
+func CreateDate(env Env, time float64) (Value, Status) {
+	return backend.CreateDate(env, time)
+}
+
+func CreatePropertyKeyLatin1(env Env, str string) (Value, Status) {
+	return backend.CreatePropertyKeyLatin1(env, str)
+}
+
+func CreatePropertyKeyUtf16(env Env, str []uint16) (Value, Status) {
+	return backend.CreatePropertyKeyUtf16(env, str)
+}
+
+func CreatePropertyKeyUtf8(env Env, str string) (Value, Status) {
+	return backend.CreatePropertyKeyUtf8(env, str)
+}
+
+func GetNodeVersion(env Env) (NodeVersion, Status) {
+	return backend.GetNodeVersion(env)
+}
+
+func GetVersion(env Env) (uint32, Status) {
+	return backend.GetVersion(env)
+}
+
+func GetModuleFileName(env Env) (string, Status) {
+	return backend.GetModuleFileName(env)
+}
+
+func ThrowSyntaxError(env Env, code, msg string) Status {
+	return backend.ThrowSyntaxError(env, code, msg)
+}
+
+func CreateSyntaxError(env Env, code, msg Value) (Value, Status) {
+	return backend.CreateSyntaxError(env, code, msg)
+}
+
+func SymbolFor(env Env, description string) (Value, Status) {
+	return backend.SymbolFor(env, description)
+}
+
+func CreatePromise(env Env) (Value, Deferred, Status) {
+	return backend.CreatePromise(env)
+}
+
+func ResolveDeferred(env Env, deferred Deferred, resolution Value) Status {
+	return backend.ResolveDeferred(env, deferred, resolution)
+}
+
+func RejectDeferred(env Env, deferred Deferred, rejection Value) Status {
+	return backend.RejectDeferred(env, deferred, rejection)
+}
+
+func GetExtendedErrorInfo(env Env) (*ExtendedError, Status) {
+	return backend.GetExtendedErrorInfo(env)
+}
+
+func CreateThreadsafeFunction(env Env, fn, asyncResource, asyncResourceName Value, maxQueueSize, initialThreadCount int, finalize ThreadsafeFunctionFinalize, callJS ThreadsafeFunctionCallJS) (ThreadsafeFunction, Status) {
+	return backend.CreateThreadsafeFunction(env, fn, asyncResource, asyncResourceName, maxQueueSize, initialThreadCount, finalize, callJS)
+}
+
+func CallThreadsafeFunction(fn ThreadsafeFunction, data any, mode ThreadsafeFunctionCallMode) Status {
+	return backend.CallThreadsafeFunction(fn, data, mode)
+}
+
+func AcquireThreadsafeFunction(fn ThreadsafeFunction) Status {
+	return backend.AcquireThreadsafeFunction(fn)
+}
+
+func ReleaseThreadsafeFunction(fn ThreadsafeFunction, mode ThreadsafeFunctionReleaseMode) Status {
+	return backend.ReleaseThreadsafeFunction(fn, mode)
+}
+
+func GetThreadsafeFunctionContext(fn ThreadsafeFunction) (unsafe.Pointer, Status) {
+	return backend.GetThreadsafeFunctionContext(fn)
+}
+
+func RefThreadsafeFunction(env Env, fn ThreadsafeFunction) Status {
+	return backend.RefThreadsafeFunction(env, fn)
+}
+
+func UnrefThreadsafeFunction(env Env, fn ThreadsafeFunction) Status {
+	return backend.UnrefThreadsafeFunction(env, fn)
+}
+
+func CreateReference(env Env, value Value, initialRefcount int) (Reference, Status) {
+	return backend.CreateReference(env, value, initialRefcount)
+}
+
+func DeleteReference(env Env, ref Reference) Status {
+	return backend.DeleteReference(env, ref)
+}
+
+func ReferenceRef(env Env, ref Reference) (int, Status) {
+	return backend.ReferenceRef(env, ref)
+}
+
+func ReferenceUnref(env Env, ref Reference) (int, Status) {
+	return backend.ReferenceUnref(env, ref)
+}
+
+func GetReferenceValue(env Env, ref Reference) (Value, Status) {
+	return backend.GetReferenceValue(env, ref)
+}
+
+func Wrap(env Env, jsObject Value, nativeObject unsafe.Pointer, finalize Finalize, finalizeHint unsafe.Pointer) Status {
+	return backend.Wrap(env, jsObject, nativeObject, finalize, finalizeHint)
+}
+
+func Unwrap(env Env, jsObject Value) (unsafe.Pointer, Status) {
+	return backend.Unwrap(env, jsObject)
+}
+
+func RemoveWrap(env Env, jsObject Value) Status {
+	return backend.RemoveWrap(env, jsObject)
+}
+
+func OpenHandleScope(env Env) (HandleScope, Status) {
+	return backend.OpenHandleScope(env)
+}
+
+func CloseHandleScope(env Env, scope HandleScope) Status {
+	return backend.CloseHandleScope(env, scope)
+}
+
+func OpenEscapableHandleScope(env Env) (EscapableHandleScope, Status) {
+	return backend.OpenEscapableHandleScope(env)
+}
+
+func CloseEscapableHandleScope(env Env, scope EscapableHandleScope) Status {
+	return backend.CloseEscapableHandleScope(env, scope)
+}
+
+func EscapeHandle(env Env, scope EscapableHandleScope, escapee Value) (Value, Status) {
+	return backend.EscapeHandle(env, scope, escapee)
+}
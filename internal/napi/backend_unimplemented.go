@@ -0,0 +1,564 @@
+package napi
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// Backend returning [StatusGenericFailure] to all calls,
+// embed in backends that implement only some calls.
+type UnimplementedBackend struct{}
+
+var _ Backend = UnimplementedBackend{}
+
+func (UnimplementedBackend) CreateAsyncWork(Env, Value, Value, AsyncExecuteCallback, AsyncCompleteCallback) (r0 AsyncWork, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) DeleteAsyncWork(Env, AsyncWork) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) QueueAsyncWork(Env, AsyncWork) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) CancelAsyncWork(Env, AsyncWork) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) InitializeInstanceData(Env) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetUndefined(Env) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetNull(Env) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetGlobal(Env) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetBoolean(Env, bool) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateObject(Env) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateArray(Env) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateArrayWithLength(Env, int) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateDouble(Env, float64) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateStringUtf8(Env, string) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateSymbol(Env, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateFunction(Env, string, Callback) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateError(Env, Value, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) Typeof(Env, Value) (r0 ValueType, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetValueDouble(Env, Value) (r0 float64, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetValueBool(Env, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetValueStringUtf8(Env, Value) (r0 string, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetValueStringUtf16(Env, Value) (r0 []uint16, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) SetProperty(Env, Value, Value, Value) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) SetElement(Env, Value, int, Value) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) StrictEquals(Env, Value, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetCbInfo(Env, CallbackInfo) (r0 GetCbInfoResult, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) Throw(Env, Value) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) ThrowError(Env, string, string) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) SetInstanceData(Env, any) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetInstanceData(Env) (r0 any, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetLibraryData(Env) (r0 *sync.Map, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateExternal(Env, unsafe.Pointer, Finalize, unsafe.Pointer) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetValueInt32(Env, Value) (r0 int32, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetValueUint32(Env, Value) (r0 uint32, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetValueInt64(Env, Value) (r0 int64, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetValueBigIntInt64(Env, Value) (r0 int64, r1 bool, _ Status) {
+	return r0, r1, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetValueBigIntWords(Env, Value, int, int, *uint64) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetValueExternal(Env, Value) (r0 unsafe.Pointer, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CoerceToBool(Env, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CoerceToNumber(Env, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CoerceToObject(Env, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CoerceToString(Env, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateBuffer(Env, int) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateBufferCopy(Env, []byte) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetBufferInfo(Env, Value) (r0 *byte, r1 int, _ Status) {
+	return r0, r1, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetBufferInfoSize(Env, Value) (r0 int, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetBufferInfoData(Env, Value) (r0 []byte, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetArrayLength(Env, Value) (r0 int, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetPrototype(Env, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) InstanceOf(Env, Value, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) IsArray(Env, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) IsBuffer(Env, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) IsError(Env, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) IsPromise(Env, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) IsTypedArray(Env, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetTypedArrayInfo(Env, Value) (r0 TypedArrayType, r1 int, r2 *byte, r3 Value, r4 int, _ Status) {
+	return r0, r1, r2, r3, r4, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateTypedArray(Env, TypedArrayType, int, Value, int) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) AdjustExternalMemory(Env, int64) (r0 int64, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateDataView(Env, int, Value, int) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetDataViewInfo(Env, Value) (r0 int, r1 *byte, r2 Value, r3 int, _ Status) {
+	return r0, r1, r2, r3, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetAllPropertyNames(Env, Value, KeyCollectionMode, KeyFilter, KeyConversion) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) HasOwnProperty(Env, Value, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) HasProperty(Env, Value, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetPropertyNames(Env, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) DefineProperties(Env, Value, []PropertyDescriptor) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetValueBigIntUint64(Env, Value) (r0 uint64, r1 bool, _ Status) {
+	return r0, r1, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateBigIntInt64(Env, int64) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateBigIntUint64(Env, uint64) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateBigIntWords(Env, int, int, *uint64) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) IsDate(Env, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) IsDetachedArrayBuffer(Env, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) DetachArrayBuffer(Env, Value) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateArrayBuffer(Env, int) (r0 Value, r1 *byte, _ Status) {
+	return r0, r1, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetArrayBufferInfo(Env, Value) (r0 *byte, r1 int, _ Status) {
+	return r0, r1, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateExternalArrayBuffer(Env, unsafe.Pointer, int, Finalize, unsafe.Pointer) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetElement(Env, Value, int) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetProperty(Env, Value, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) DeleteProperty(Env, Value, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) SetNamedProperty(Env, Value, string, Value) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetNamedProperty(Env, Value, string) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) HasNamedProperty(Env, Value, string) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) HasElement(Env, Value, int) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) DeleteElement(Env, Value, int) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) ObjectFreeze(Env, Value) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) ObjectSeal(Env, Value) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) ThrowTypeError(Env, string, string) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) ThrowRangeError(Env, string, string) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateTypeError(Env, Value, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateRangeError(Env, Value, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) IsExceptionPending(Env) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetAndClearLastException(Env) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CloseCallbackScope(Env, CallbackScope) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateInt32(Env, int32) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateUint32(Env, uint32) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateInt64(Env, int64) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateStringLatin1(Env, string) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateStringUtf16(Env, []uint16) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CallFunction(Env, Value, Value, int, []Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) RunScript(Env, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetNewTarget(Env, CallbackInfo) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) NewInstance(Env, Value, int, []Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) IsDataView(Env, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) IsArrayBuffer(Env, Value) (r0 bool, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetDateValue(Env, Value) (r0 float64, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateDate(Env, float64) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreatePropertyKeyLatin1(Env, string) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreatePropertyKeyUtf16(Env, []uint16) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreatePropertyKeyUtf8(Env, string) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetNodeVersion(Env) (r0 NodeVersion, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetVersion(Env) (r0 uint32, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetModuleFileName(Env) (r0 string, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) ThrowSyntaxError(Env, string, string) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateSyntaxError(Env, Value, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) SymbolFor(Env, string) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreatePromise(Env) (r0 Value, r1 Deferred, _ Status) {
+	return r0, r1, StatusGenericFailure
+}
+
+func (UnimplementedBackend) ResolveDeferred(Env, Deferred, Value) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) RejectDeferred(Env, Deferred, Value) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetExtendedErrorInfo(Env) (r0 *ExtendedError, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateThreadsafeFunction(Env, Value, Value, Value, int, int, ThreadsafeFunctionFinalize, ThreadsafeFunctionCallJS) (r0 ThreadsafeFunction, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CallThreadsafeFunction(ThreadsafeFunction, any, ThreadsafeFunctionCallMode) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) AcquireThreadsafeFunction(ThreadsafeFunction) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) ReleaseThreadsafeFunction(ThreadsafeFunction, ThreadsafeFunctionReleaseMode) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetThreadsafeFunctionContext(ThreadsafeFunction) (r0 unsafe.Pointer, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) RefThreadsafeFunction(Env, ThreadsafeFunction) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) UnrefThreadsafeFunction(Env, ThreadsafeFunction) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) CreateReference(Env, Value, int) (r0 Reference, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) DeleteReference(Env, Reference) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) ReferenceRef(Env, Reference) (r0 int, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) ReferenceUnref(Env, Reference) (r0 int, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) GetReferenceValue(Env, Reference) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) Wrap(Env, Value, unsafe.Pointer, Finalize, unsafe.Pointer) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) Unwrap(Env, Value) (r0 unsafe.Pointer, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) RemoveWrap(Env, Value) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) OpenHandleScope(Env) (r0 HandleScope, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CloseHandleScope(Env, HandleScope) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) OpenEscapableHandleScope(Env) (r0 EscapableHandleScope, _ Status) {
+	return r0, StatusGenericFailure
+}
+
+func (UnimplementedBackend) CloseEscapableHandleScope(Env, EscapableHandleScope) Status {
+	return StatusGenericFailure
+}
+
+func (UnimplementedBackend) EscapeHandle(Env, EscapableHandleScope, Value) (r0 Value, _ Status) {
+	return r0, StatusGenericFailure
+}
@@ -0,0 +1,45 @@
+package napi
+
+/*
+#cgo CFLAGS: -DDEBUG
+#cgo CFLAGS: -D_DEBUG
+#cgo CFLAGS: -DV8_ENABLE_CHECKS
+#cgo !napi8,!napi9,!napi10 CFLAGS: -DNAPI_EXPERIMENTAL
+#cgo napi8 CFLAGS: -DNAPI_VERSION=8
+#cgo napi9 CFLAGS: -DNAPI_VERSION=9
+#cgo napi10 CFLAGS: -DNAPI_VERSION=10
+#cgo !napi_system_headers CPPFLAGS: -I${SRCDIR}/include
+#cgo napi_system_headers CFLAGS: -I/usr/local/include
+#cgo CFLAGS: -DNODE_API_EXPERIMENTAL_BASIC_ENV_OPT_OUT
+#cgo CXXFLAGS: -std=c++11
+
+#cgo darwin LDFLAGS: -Wl,-undefined,dynamic_lookup
+#cgo darwin LDFLAGS: -Wl,-no_pie
+#cgo darwin LDFLAGS: -Wl,-search_paths_first
+#cgo (darwin && amd64) LDFLAGS: -arch x86_64
+#cgo (darwin && arm64) LDFLAGS: -arch arm64
+
+#cgo linux LDFLAGS: -Wl,-unresolved-symbols=ignore-all
+
+#cgo LDFLAGS: -L${SRCDIR}
+
+#include <stdint.h>
+#ifdef _WIN32
+#include <windows.h>
+static uintptr_t currentThread() { return (uintptr_t)GetCurrentThreadId(); }
+#else
+#include <pthread.h>
+static uintptr_t currentThread() { return (uintptr_t)pthread_self(); }
+#endif
+*/
+import "C"
+
+// Backend calling Node-API with cgo
+type cgoBackend struct{}
+
+var defaultBackend Backend = cgoBackend{}
+
+// Return ID of current thread, used to check if Go code is running in Javascript thread.
+func CurrentThread() uintptr {
+	return uintptr(C.currentThread())
+}
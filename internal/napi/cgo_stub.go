@@ -0,0 +1,12 @@
+//go:build !cgo
+
+package napi
+
+// Without cgo Node-API can't be called, all calls return napi_generic_failure
+// until other backend is set with [SetBackend].
+var defaultBackend Backend = UnimplementedBackend{}
+
+// Without cgo thread is unknown, always return 0.
+func CurrentThread() uintptr {
+	return 0
+}
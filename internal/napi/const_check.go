@@ -0,0 +1,138 @@
+package napi
+
+// #include <node/node_api.h>
+import "C"
+
+// An "invalid array index" compiler error signifies that the constant values have changed,
+// constants are declared without cgo to build package with CGO_ENABLED=0.
+func _() {
+	var x [1]struct{}
+	_ = x[ValueTypeUndefined-C.napi_undefined]
+	_ = x[C.napi_undefined-ValueTypeUndefined]
+	_ = x[ValueTypeNull-C.napi_null]
+	_ = x[C.napi_null-ValueTypeNull]
+	_ = x[ValueTypeBoolean-C.napi_boolean]
+	_ = x[C.napi_boolean-ValueTypeBoolean]
+	_ = x[ValueTypeNumber-C.napi_number]
+	_ = x[C.napi_number-ValueTypeNumber]
+	_ = x[ValueTypeString-C.napi_string]
+	_ = x[C.napi_string-ValueTypeString]
+	_ = x[ValueTypeSymbol-C.napi_symbol]
+	_ = x[C.napi_symbol-ValueTypeSymbol]
+	_ = x[ValueTypeObject-C.napi_object]
+	_ = x[C.napi_object-ValueTypeObject]
+	_ = x[ValueTypeFunction-C.napi_function]
+	_ = x[C.napi_function-ValueTypeFunction]
+	_ = x[ValueTypeExternal-C.napi_external]
+	_ = x[C.napi_external-ValueTypeExternal]
+	_ = x[ValueTypeBigint-C.napi_bigint]
+	_ = x[C.napi_bigint-ValueTypeBigint]
+	_ = x[TypedArrayInt8Array-C.napi_int8_array]
+	_ = x[C.napi_int8_array-TypedArrayInt8Array]
+	_ = x[TypedArrayUint8Array-C.napi_uint8_array]
+	_ = x[C.napi_uint8_array-TypedArrayUint8Array]
+	_ = x[TypedArrayUint8ClampedArray-C.napi_uint8_clamped_array]
+	_ = x[C.napi_uint8_clamped_array-TypedArrayUint8ClampedArray]
+	_ = x[TypedArrayInt16Array-C.napi_int16_array]
+	_ = x[C.napi_int16_array-TypedArrayInt16Array]
+	_ = x[TypedArrayUint16Array-C.napi_uint16_array]
+	_ = x[C.napi_uint16_array-TypedArrayUint16Array]
+	_ = x[TypedArrayInt32Array-C.napi_int32_array]
+	_ = x[C.napi_int32_array-TypedArrayInt32Array]
+	_ = x[TypedArrayUint32Array-C.napi_uint32_array]
+	_ = x[C.napi_uint32_array-TypedArrayUint32Array]
+	_ = x[TypedArrayFloat32Array-C.napi_float32_array]
+	_ = x[C.napi_float32_array-TypedArrayFloat32Array]
+	_ = x[TypedArrayFloat64Array-C.napi_float64_array]
+	_ = x[C.napi_float64_array-TypedArrayFloat64Array]
+	_ = x[TypedArrayBigInt64Array-C.napi_bigint64_array]
+	_ = x[C.napi_bigint64_array-TypedArrayBigInt64Array]
+	_ = x[TypedArrayBigUint64Array-C.napi_biguint64_array]
+	_ = x[C.napi_biguint64_array-TypedArrayBigUint64Array]
+	_ = x[KeyIncludePrototypes-C.napi_key_include_prototypes]
+	_ = x[C.napi_key_include_prototypes-KeyIncludePrototypes]
+	_ = x[KeyOwnOnly-C.napi_key_own_only]
+	_ = x[C.napi_key_own_only-KeyOwnOnly]
+	_ = x[KeyAllProperties-C.napi_key_all_properties]
+	_ = x[C.napi_key_all_properties-KeyAllProperties]
+	_ = x[KeyWritable-C.napi_key_writable]
+	_ = x[C.napi_key_writable-KeyWritable]
+	_ = x[KeyEnumerable-C.napi_key_enumerable]
+	_ = x[C.napi_key_enumerable-KeyEnumerable]
+	_ = x[KeyConfigurable-C.napi_key_configurable]
+	_ = x[C.napi_key_configurable-KeyConfigurable]
+	_ = x[KeySkipStrings-C.napi_key_skip_strings]
+	_ = x[C.napi_key_skip_strings-KeySkipStrings]
+	_ = x[KeySkipSymbols-C.napi_key_skip_symbols]
+	_ = x[C.napi_key_skip_symbols-KeySkipSymbols]
+	_ = x[KeyKeepNumbers-C.napi_key_keep_numbers]
+	_ = x[C.napi_key_keep_numbers-KeyKeepNumbers]
+	_ = x[KeyNumbersToStrings-C.napi_key_numbers_to_strings]
+	_ = x[C.napi_key_numbers_to_strings-KeyNumbersToStrings]
+	_ = x[Default-C.napi_default]
+	_ = x[C.napi_default-Default]
+	_ = x[Writable-C.napi_writable]
+	_ = x[C.napi_writable-Writable]
+	_ = x[Enumerable-C.napi_enumerable]
+	_ = x[C.napi_enumerable-Enumerable]
+	_ = x[Configurable-C.napi_configurable]
+	_ = x[C.napi_configurable-Configurable]
+	_ = x[Static-C.napi_static]
+	_ = x[C.napi_static-Static]
+	_ = x[DefaultMethod-C.napi_default_method]
+	_ = x[C.napi_default_method-DefaultMethod]
+	_ = x[DefaultJSProperty-C.napi_default_jsproperty]
+	_ = x[C.napi_default_jsproperty-DefaultJSProperty]
+	_ = x[StatusOK-C.napi_ok]
+	_ = x[C.napi_ok-StatusOK]
+	_ = x[StatusInvalidArg-C.napi_invalid_arg]
+	_ = x[C.napi_invalid_arg-StatusInvalidArg]
+	_ = x[StatusObjectExpected-C.napi_object_expected]
+	_ = x[C.napi_object_expected-StatusObjectExpected]
+	_ = x[StatusStringExpected-C.napi_string_expected]
+	_ = x[C.napi_string_expected-StatusStringExpected]
+	_ = x[StatusNameExpected-C.napi_name_expected]
+	_ = x[C.napi_name_expected-StatusNameExpected]
+	_ = x[StatusFunctionExpected-C.napi_function_expected]
+	_ = x[C.napi_function_expected-StatusFunctionExpected]
+	_ = x[StatusNumberExpected-C.napi_number_expected]
+	_ = x[C.napi_number_expected-StatusNumberExpected]
+	_ = x[StatusBooleanExpected-C.napi_boolean_expected]
+	_ = x[C.napi_boolean_expected-StatusBooleanExpected]
+	_ = x[StatusArrayExpected-C.napi_array_expected]
+	_ = x[C.napi_array_expected-StatusArrayExpected]
+	_ = x[StatusGenericFailure-C.napi_generic_failure]
+	_ = x[C.napi_generic_failure-StatusGenericFailure]
+	_ = x[StatusPendingException-C.napi_pending_exception]
+	_ = x[C.napi_pending_exception-StatusPendingException]
+	_ = x[StatusCancelled-C.napi_cancelled]
+	_ = x[C.napi_cancelled-StatusCancelled]
+	_ = x[StatusEscapeCalledTwice-C.napi_escape_called_twice]
+	_ = x[C.napi_escape_called_twice-StatusEscapeCalledTwice]
+	_ = x[StatusHandleScopeMismatch-C.napi_handle_scope_mismatch]
+	_ = x[C.napi_handle_scope_mismatch-StatusHandleScopeMismatch]
+	_ = x[StatusCallbackScopeMismatch-C.napi_callback_scope_mismatch]
+	_ = x[C.napi_callback_scope_mismatch-StatusCallbackScopeMismatch]
+	_ = x[StatusQueueFull-C.napi_queue_full]
+	_ = x[C.napi_queue_full-StatusQueueFull]
+	_ = x[StatusClosing-C.napi_closing]
+	_ = x[C.napi_closing-StatusClosing]
+	_ = x[StatusBigintExpected-C.napi_bigint_expected]
+	_ = x[C.napi_bigint_expected-StatusBigintExpected]
+	_ = x[StatusDateExpected-C.napi_date_expected]
+	_ = x[C.napi_date_expected-StatusDateExpected]
+	_ = x[StatusArraybufferExpected-C.napi_arraybuffer_expected]
+	_ = x[C.napi_arraybuffer_expected-StatusArraybufferExpected]
+	_ = x[StatusDetachableArraybufferExpected-C.napi_detachable_arraybuffer_expected]
+	_ = x[C.napi_detachable_arraybuffer_expected-StatusDetachableArraybufferExpected]
+	_ = x[StatusWouldDeadlock-C.napi_would_deadlock]
+	_ = x[C.napi_would_deadlock-StatusWouldDeadlock]
+	_ = x[Release-C.napi_tsfn_release]
+	_ = x[C.napi_tsfn_release-Release]
+	_ = x[Abort-C.napi_tsfn_abort]
+	_ = x[C.napi_tsfn_abort-Abort]
+	_ = x[NonBlocking-C.napi_tsfn_nonblocking]
+	_ = x[C.napi_tsfn_nonblocking-NonBlocking]
+	_ = x[Blocking-C.napi_tsfn_blocking]
+	_ = x[C.napi_tsfn_blocking-Blocking]
+}
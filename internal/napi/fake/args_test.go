@@ -0,0 +1,181 @@
+package fake_test
+
+import (
+	"fmt"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+)
+
+// Create function calling callback and return string of result
+func callbackFunc(t testing.TB, env napi.EnvType, callback func(ci *napi.CallbackInfo) (any, error)) *napi.Function {
+	t.Helper()
+	fn, err := napi.CreateFunction(env, "callback", func(ci *napi.CallbackInfo) (napi.ValueType, error) {
+		value, err := callback(ci)
+		if err != nil {
+			return nil, err
+		}
+		return napi.CreateString(ci.Env, fmt.Sprint(value))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fn
+}
+
+type argsOptions struct {
+	Name    string
+	Count   napi.Optional[int]
+	Ignored string `napi:"-"`
+	Flag    *bool
+}
+
+func TestCallbackInfoArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		callback func(ci *napi.CallbackInfo) (any, error)
+		args     []any
+		want     string
+	}{
+		{"arg", func(ci *napi.CallbackInfo) (any, error) { return napi.Arg[string](ci, 1) }, []any{1, "second"}, "second"},
+		{"arg missing pointer", func(ci *napi.CallbackInfo) (any, error) {
+			value, err := napi.Arg[*int](ci, 0)
+			return value == nil, err
+		}, nil, "true"},
+		{"arg undefined optional", func(ci *napi.CallbackInfo) (any, error) {
+			value, err := napi.Arg[napi.Optional[int]](ci, 0)
+			return value.Valid, err
+		}, []any{nil}, "false"},
+		{"arg or missing", func(ci *napi.CallbackInfo) (any, error) { return napi.ArgOr(ci, 0, 2) }, nil, "2"},
+		{"arg or undefined", func(ci *napi.CallbackInfo) (any, error) { return napi.ArgOr(ci, 0, 2) }, []any{nil}, "2"},
+		{"arg or value", func(ci *napi.CallbackInfo) (any, error) { return napi.ArgOr(ci, 0, 2) }, []any{5}, "5"},
+		{"args into", func(ci *napi.CallbackInfo) (any, error) {
+			var args argsOptions
+			err := ci.ArgsInto(&args)
+			return fmt.Sprintf("%s %d %q %t", args.Name, args.Count.Or(1), args.Ignored, args.Flag == nil), err
+		}, []any{"go", 3}, `go 3 "" true`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := newEnv(t)
+			res, err := callbackFunc(t, env, test.callback).Call(jsArgs(t, env, test.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			} else if got := describe(t, res); got != test.want {
+				t.Errorf("call with %#v = %q, want %q", test.args, got, test.want)
+			}
+		})
+	}
+}
+
+func TestCallbackInfoThisAs(t *testing.T) {
+	env := newEnv(t)
+	this, err := napi.ValueOf(env, user{Name: "Ana"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn := callbackFunc(t, env, func(ci *napi.CallbackInfo) (any, error) {
+		value, err := napi.ThisAs[user](ci)
+		return value.Name, err
+	})
+	res, err := fn.CallWithGlobal(this)
+	if err != nil {
+		t.Fatal(err)
+	} else if got := describe(t, res); got != "Ana" {
+		t.Errorf("this name = %q, want \"Ana\"", got)
+	}
+}
+
+func TestCallbackInfoArgsError(t *testing.T) {
+	tests := []struct {
+		name     string
+		callback func(ci *napi.CallbackInfo) (any, error)
+		this     any
+		args     []any
+		code     string
+		message  string
+	}{
+		{"arg wrong type", func(ci *napi.CallbackInfo) (any, error) { return napi.Arg[int](ci, 0) }, nil, []any{"1"},
+			"ERR_INVALID_ARG_TYPE", "The argument at index 0 must be of type number or bigint. Received type string"},
+		{"arg out of range", func(ci *napi.CallbackInfo) (any, error) { return napi.Arg[string](ci, 2) }, nil, []any{"a"},
+			"ERR_MISSING_ARGS", "The argument at index 2 is required, expected string"},
+		{"arg undefined", func(ci *napi.CallbackInfo) (any, error) { return napi.Arg[bool](ci, 0) }, nil, []any{nil},
+			"ERR_INVALID_ARG_TYPE", "The argument at index 0 must be of type boolean. Received type undefined"},
+		{"arg or wrong type", func(ci *napi.CallbackInfo) (any, error) { return napi.ArgOr(ci, 1, "def") }, nil, []any{"a", true},
+			"ERR_INVALID_ARG_TYPE", "The argument at index 1 must be of type string. Received type boolean"},
+		{"this wrong type", func(ci *napi.CallbackInfo) (any, error) { return napi.ThisAs[string](ci) }, 1, nil,
+			"ERR_INVALID_ARG_TYPE", "The this value must be of type string. Received type number"},
+		{"args into wrong type", func(ci *napi.CallbackInfo) (any, error) {
+			var args argsOptions
+			return nil, ci.ArgsInto(&args)
+		}, nil, []any{"go", "3"}, "ERR_INVALID_ARG_TYPE", "The argument at index 1 must be of type number or bigint. Received type string"},
+		{"args into out of range", func(ci *napi.CallbackInfo) (any, error) {
+			var args argsOptions
+			return nil, ci.ArgsInto(&args)
+		}, nil, nil, "ERR_MISSING_ARGS", "The argument at index 0 is required, expected string"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := newEnv(t)
+			this, err := napi.ValueOf(env, test.this)
+			if err != nil {
+				t.Fatal(err)
+			} else if this == nil {
+				if this, err = env.Undefined(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err = callbackFunc(t, env, test.callback).CallWithGlobal(this, jsArgs(t, env, test.args...)...); err == nil {
+				t.Fatal("call not return error")
+			}
+			exception := thrown(t, env)
+			if name := propertyString(t, exception, "name"); name != "TypeError" {
+				t.Errorf("name = %q, want \"TypeError\"", name)
+			}
+			if code := propertyString(t, exception, "code"); code != test.code {
+				t.Errorf("code = %q, want %q", code, test.code)
+			}
+			if message := propertyString(t, exception, "message"); message != test.message {
+				t.Errorf("message = %q, want %q", message, test.message)
+			}
+		})
+	}
+}
+
+func TestCallbackInfoArgsIntoNotStruct(t *testing.T) {
+	var name string
+	if err := (&napi.CallbackInfo{}).ArgsInto(&name); err == nil {
+		t.Error("ArgsInto with pointer to string not return error")
+	}
+}
+
+func TestCallbackInfoData(t *testing.T) {
+	env := newEnv(t)
+	calls := 0
+	fn, err := napi.CreateFunctionWithData(env, "count", &calls, func(ci *napi.CallbackInfo) (napi.ValueType, error) {
+		count := ci.Data().(*int)
+		*count++
+		return napi.CreateNumber(ci.Env, *count)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for want := 1; want <= 2; want++ {
+		res, err := fn.Call()
+		if err != nil {
+			t.Fatal(err)
+		} else if got := describe(t, res); got != fmt.Sprint(want) {
+			t.Errorf("call %d return %q", want, got)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("data changed to %d, want 2", calls)
+	}
+
+	withoutData := callbackFunc(t, env, func(ci *napi.CallbackInfo) (any, error) { return ci.Data() == nil, nil })
+	if res, err := withoutData.Call(); err != nil {
+		t.Fatal(err)
+	} else if got := describe(t, res); got != "true" {
+		t.Errorf("Data of CreateFunction is not nil")
+	}
+}
@@ -0,0 +1,1338 @@
+package fake
+
+import (
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf16"
+	"unsafe"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// napi_callback_info of fake function call
+type callbackInfo struct {
+	this      *Value
+	args      []*Value
+	newTarget *Value
+}
+
+type reference struct {
+	value *Value
+	count int
+}
+
+// Messages of napi_get_last_error_info, same of Node.js
+var statusMessages = []string{
+	"",
+	"Invalid argument",
+	"An object was expected",
+	"A string was expected",
+	"A string or symbol was expected",
+	"A function was expected",
+	"A number was expected",
+	"A boolean was expected",
+	"An array was expected",
+	"Unknown failure",
+	"An exception is pending",
+	"The async work item was cancelled",
+	"napi_escape_handle already called on scope",
+	"Invalid handle scope usage",
+	"Invalid callback scope usage",
+	"Thread-safe function queue is full",
+	"Thread-safe function handle is closing",
+	"A bigint was expected",
+	"A date was expected",
+	"An arraybuffer was expected",
+	"A detachable arraybuffer was expected",
+	"Main thread would deadlock",
+}
+
+// Size in bytes of typed array elements
+var typedArraySize = map[napi.TypedArrayType]int{
+	napi.TypedArrayInt8Array:         1,
+	napi.TypedArrayUint8Array:        1,
+	napi.TypedArrayUint8ClampedArray: 1,
+	napi.TypedArrayInt16Array:        2,
+	napi.TypedArrayUint16Array:       2,
+	napi.TypedArrayInt32Array:        4,
+	napi.TypedArrayUint32Array:       4,
+	napi.TypedArrayFloat32Array:      4,
+	napi.TypedArrayFloat64Array:      8,
+	napi.TypedArrayBigInt64Array:     8,
+	napi.TypedArrayBigUint64Array:    8,
+}
+
+func (e *Env) napiEnv() napi.Env {
+	return napi.Env(unsafe.Pointer(e))
+}
+
+// Return status if exception is pending, functions that can run Javascript fail with pending exception
+func (e *Env) preamble() napi.Status {
+	if e.exception != nil {
+		return napi.StatusPendingException
+	}
+	return napi.StatusOK
+}
+
+// Call function with this and args
+func (e *Env) call(this, fn *Value, args []*Value, newTarget *Value) (*Value, napi.Status) {
+	if e.exception != nil {
+		return nil, napi.StatusPendingException
+	} else if fn.kind != napi.ValueTypeFunction {
+		return nil, napi.StatusFunctionExpected
+	}
+	if this == nil {
+		this = e.undefined
+	}
+	info := &callbackInfo{this: this, args: args, newTarget: newTarget}
+	result := fn.callback(e.napiEnv(), napi.CallbackInfo(unsafe.Pointer(info)))
+	if e.exception != nil {
+		return nil, napi.StatusPendingException
+	} else if result == nil {
+		return e.undefined, napi.StatusOK
+	}
+	return valueOf(result), napi.StatusOK
+}
+
+// Byte slice of ArrayBuffer or view
+func (v *Value) bytes() []byte {
+	switch v.class {
+	case classArrayBuffer:
+		return *v.data
+	case classTypedArray, classBuffer:
+		return (*v.buffer.data)[v.offset : v.offset+v.size*typedArraySize[v.arrayType]]
+	case classDataView:
+		return (*v.buffer.data)[v.offset : v.offset+v.size]
+	}
+	return nil
+}
+
+func (v *Value) isView() bool {
+	return v.object != nil && (v.class == classTypedArray || v.class == classBuffer || v.class == classDataView)
+}
+
+func dataPointer(data []byte) *byte {
+	if len(data) == 0 {
+		return nil
+	}
+	return &data[0]
+}
+
+func dateOf(ms float64) time.Time {
+	return time.UnixMilli(int64(ms)).UTC()
+}
+
+func (e *Env) newArrayBuffer(data []byte) *Value {
+	buffer := e.newObject(classArrayBuffer, e.arrayBufferProto)
+	buffer.data = &data
+	return buffer
+}
+
+// Create typed array, buffer or dataview to arrayBuffer
+func (e *Env) newView(class class, proto, arrayBuffer *Value, arrayType napi.TypedArrayType, offset, size int) *Value {
+	view := e.newObject(class, proto)
+	view.buffer, view.arrayType, view.offset, view.size = arrayBuffer, arrayType, offset, size
+	return view
+}
+
+func (e *Env) newString(str string) (napi.Value, napi.Status) {
+	return e.string(str).napiValue(), e.set(napi.StatusOK)
+}
+
+// Check if value is object and return status
+func (e *Env) objectArg(value napi.Value) (*Value, napi.Status) {
+	v := valueOf(value)
+	if v == nil {
+		return nil, napi.StatusInvalidArg
+	} else if v.object == nil || v.kind == napi.ValueTypeExternal {
+		return nil, napi.StatusObjectExpected
+	}
+	return v, napi.StatusOK
+}
+
+// Error constructors
+func (e *Env) createError(proto *Value, code, msg napi.Value) (napi.Value, napi.Status) {
+	msgValue, codeValue := valueOf(msg), valueOf(code)
+	if msgValue == nil {
+		return nil, e.set(napi.StatusInvalidArg)
+	} else if msgValue.kind != napi.ValueTypeString || (codeValue != nil && codeValue.kind != napi.ValueTypeString) {
+		return nil, e.set(napi.StatusStringExpected)
+	}
+	return e.newError(proto, codeValue, msgValue).napiValue(), e.set(napi.StatusOK)
+}
+
+func (e *Env) throwError(proto *Value, code, msg string) napi.Status {
+	if e.exception != nil {
+		return e.set(napi.StatusPendingException)
+	}
+	var codeValue *Value
+	if code != "" {
+		codeValue = e.string(code)
+	}
+	e.exception = e.newError(proto, codeValue, e.string(msg))
+	return e.set(napi.StatusOK)
+}
+
+func (Backend) InitializeInstanceData(env napi.Env) napi.Status {
+	return envOf(env).set(napi.StatusOK)
+}
+
+func (Backend) SetInstanceData(env napi.Env, data any) napi.Status {
+	e := envOf(env)
+	e.data = data
+	return e.set(napi.StatusOK)
+}
+
+func (Backend) GetInstanceData(env napi.Env) (any, napi.Status) {
+	e := envOf(env)
+	return e.data, e.set(napi.StatusOK)
+}
+
+func (Backend) GetLibraryData(env napi.Env) (*sync.Map, napi.Status) {
+	e := envOf(env)
+	return &e.library, e.set(napi.StatusOK)
+}
+
+func (Backend) GetVersion(env napi.Env) (uint32, napi.Status) {
+	return Version, envOf(env).set(napi.StatusOK)
+}
+
+func (Backend) GetNodeVersion(env napi.Env) (napi.NodeVersion, napi.Status) {
+	return napi.NodeVersion{Major: 22, Minor: 20, Patch: 0, Release: "node"}, envOf(env).set(napi.StatusOK)
+}
+
+func (Backend) GetModuleFileName(env napi.Env) (string, napi.Status) {
+	return "file:///fake.node", envOf(env).set(napi.StatusOK)
+}
+
+func (Backend) GetExtendedErrorInfo(env napi.Env) (*napi.ExtendedError, napi.Status) {
+	e := envOf(env)
+	info := &napi.ExtendedError{StatusCode: e.lastStatus, Message: e.message}
+	if info.Message == "" && int(e.lastStatus) < len(statusMessages) {
+		info.Message = statusMessages[e.lastStatus]
+	}
+	return info, napi.StatusOK
+}
+
+func (Backend) RunScript(env napi.Env, script napi.Value) (napi.Value, napi.Status) {
+	return nil, envOf(env).unsupported("napi_run_script")
+}
+
+func (Backend) CreateAsyncWork(env napi.Env, asyncResource, asyncResourceName napi.Value, execute napi.AsyncExecuteCallback, complete napi.AsyncCompleteCallback) (napi.AsyncWork, napi.Status) {
+	return napi.AsyncWork{}, envOf(env).unsupported("napi_create_async_work")
+}
+
+func (Backend) CreateThreadsafeFunction(env napi.Env, fn, asyncResource, asyncResourceName napi.Value, maxQueueSize, initialThreadCount int, finalize napi.ThreadsafeFunctionFinalize, callJS napi.ThreadsafeFunctionCallJS) (napi.ThreadsafeFunction, napi.Status) {
+	return nil, envOf(env).unsupported("napi_create_threadsafe_function")
+}
+
+func (Backend) GetUndefined(env napi.Env) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.undefined.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) GetNull(env napi.Env) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.null.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) GetGlobal(env napi.Env) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.global.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) GetBoolean(env napi.Env, value bool) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.boolean(value).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateObject(env napi.Env) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.newObject(classObject, e.objectProto).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateArray(env napi.Env) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.newObject(classArray, e.arrayProto).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateArrayWithLength(env napi.Env, length int) (napi.Value, napi.Status) {
+	e := envOf(env)
+	arr := e.newObject(classArray, e.arrayProto)
+	arr.length = max(length, 0)
+	return arr.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateDouble(env napi.Env, value float64) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.number(value).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateInt32(env napi.Env, value int32) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.number(float64(value)).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateUint32(env napi.Env, value uint32) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.number(float64(value)).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateInt64(env napi.Env, value int64) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.number(float64(value)).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateStringUtf8(env napi.Env, str string) (napi.Value, napi.Status) {
+	return envOf(env).newString(str)
+}
+
+func (Backend) CreateStringLatin1(env napi.Env, str string) (napi.Value, napi.Status) {
+	runes := make([]rune, len(str))
+	for index := range len(str) {
+		runes[index] = rune(str[index])
+	}
+	return envOf(env).newString(string(runes))
+}
+
+func (Backend) CreateStringUtf16(env napi.Env, str []uint16) (napi.Value, napi.Status) {
+	return envOf(env).newString(string(utf16.Decode(str)))
+}
+
+func (b Backend) CreatePropertyKeyUtf8(env napi.Env, str string) (napi.Value, napi.Status) {
+	return b.CreateStringUtf8(env, str)
+}
+
+func (b Backend) CreatePropertyKeyLatin1(env napi.Env, str string) (napi.Value, napi.Status) {
+	return b.CreateStringLatin1(env, str)
+}
+
+func (b Backend) CreatePropertyKeyUtf16(env napi.Env, str []uint16) (napi.Value, napi.Status) {
+	return b.CreateStringUtf16(env, str)
+}
+
+func (Backend) CreateSymbol(env napi.Env, description napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	symbol := &Value{kind: napi.ValueTypeSymbol}
+	if desc := valueOf(description); desc != nil {
+		if desc.kind != napi.ValueTypeString {
+			return nil, e.set(napi.StatusStringExpected)
+		}
+		symbol.str = desc.str
+	}
+	return symbol.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) SymbolFor(env napi.Env, description string) (napi.Value, napi.Status) {
+	e := envOf(env)
+	symbol, ok := e.symbols[description]
+	if !ok {
+		symbol = &Value{kind: napi.ValueTypeSymbol, str: description}
+		e.symbols[description] = symbol
+	}
+	return symbol.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateFunction(env napi.Env, name string, cb napi.Callback) (napi.Value, napi.Status) {
+	e := envOf(env)
+	if cb == nil {
+		return nil, e.set(napi.StatusInvalidArg)
+	}
+	return e.newFunction(name, cb).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateError(env napi.Env, code, msg napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.createError(e.errorProto, code, msg)
+}
+
+func (Backend) CreateTypeError(env napi.Env, code, msg napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.createError(e.typeErrorProto, code, msg)
+}
+
+func (Backend) CreateRangeError(env napi.Env, code, msg napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.createError(e.rangeErrorProto, code, msg)
+}
+
+func (Backend) CreateSyntaxError(env napi.Env, code, msg napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.createError(e.syntaxErrorProto, code, msg)
+}
+
+func (Backend) Throw(env napi.Env, err napi.Value) napi.Status {
+	e := envOf(env)
+	if v := valueOf(err); v == nil {
+		return e.set(napi.StatusInvalidArg)
+	} else if e.exception != nil {
+		return e.set(napi.StatusPendingException)
+	} else {
+		e.exception = v
+	}
+	return e.set(napi.StatusOK)
+}
+
+func (Backend) ThrowError(env napi.Env, code, msg string) napi.Status {
+	e := envOf(env)
+	return e.throwError(e.errorProto, code, msg)
+}
+
+func (Backend) ThrowTypeError(env napi.Env, code, msg string) napi.Status {
+	e := envOf(env)
+	return e.throwError(e.typeErrorProto, code, msg)
+}
+
+func (Backend) ThrowRangeError(env napi.Env, code, msg string) napi.Status {
+	e := envOf(env)
+	return e.throwError(e.rangeErrorProto, code, msg)
+}
+
+func (Backend) ThrowSyntaxError(env napi.Env, code, msg string) napi.Status {
+	e := envOf(env)
+	return e.throwError(e.syntaxErrorProto, code, msg)
+}
+
+func (Backend) IsExceptionPending(env napi.Env) (bool, napi.Status) {
+	e := envOf(env)
+	return e.exception != nil, e.set(napi.StatusOK)
+}
+
+func (Backend) GetAndClearLastException(env napi.Env) (napi.Value, napi.Status) {
+	e := envOf(env)
+	exception := e.exception
+	if exception == nil {
+		exception = e.undefined
+	}
+	e.exception = nil
+	return exception.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) Typeof(env napi.Env, value napi.Value) (napi.ValueType, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return 0, e.set(napi.StatusInvalidArg)
+	}
+	return v.kind, e.set(napi.StatusOK)
+}
+
+func (Backend) GetValueDouble(env napi.Env, value napi.Value) (float64, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return 0, e.set(napi.StatusInvalidArg)
+	} else if v.kind != napi.ValueTypeNumber {
+		return 0, e.set(napi.StatusNumberExpected)
+	}
+	return v.number, e.set(napi.StatusOK)
+}
+
+// Convert number to integer with modulo 2^32, same of ToInt32 and ToUint32
+func modulo32(number float64) uint32 {
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0
+	}
+	number = math.Mod(math.Trunc(number), 1<<32)
+	if number < 0 {
+		number += 1 << 32
+	}
+	return uint32(number)
+}
+
+func (b Backend) GetValueInt32(env napi.Env, value napi.Value) (int32, napi.Status) {
+	number, status := b.GetValueDouble(env, value)
+	return int32(modulo32(number)), status
+}
+
+func (b Backend) GetValueUint32(env napi.Env, value napi.Value) (uint32, napi.Status) {
+	number, status := b.GetValueDouble(env, value)
+	return modulo32(number), status
+}
+
+func (b Backend) GetValueInt64(env napi.Env, value napi.Value) (int64, napi.Status) {
+	number, status := b.GetValueDouble(env, value)
+	switch {
+	case math.IsNaN(number), math.IsInf(number, 0):
+		return 0, status
+	case number >= math.MaxInt64 || number < math.MinInt64:
+		return math.MinInt64, status
+	}
+	return int64(number), status
+}
+
+func (Backend) GetValueBool(env napi.Env, value napi.Value) (bool, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return false, e.set(napi.StatusInvalidArg)
+	} else if v.kind != napi.ValueTypeBoolean {
+		return false, e.set(napi.StatusBooleanExpected)
+	}
+	return v.boolean, e.set(napi.StatusOK)
+}
+
+func (Backend) GetValueStringUtf8(env napi.Env, value napi.Value) (string, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return "", e.set(napi.StatusInvalidArg)
+	} else if v.kind != napi.ValueTypeString {
+		return "", e.set(napi.StatusStringExpected)
+	}
+	return v.str, e.set(napi.StatusOK)
+}
+
+func (b Backend) GetValueStringUtf16(env napi.Env, value napi.Value) ([]uint16, napi.Status) {
+	str, status := b.GetValueStringUtf8(env, value)
+	if status != napi.StatusOK {
+		return nil, status
+	}
+	return utf16Of(str), status
+}
+
+func (Backend) CreateBigIntInt64(env napi.Env, value int64) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return (&Value{kind: napi.ValueTypeBigint, bigint: big.NewInt(value)}).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateBigIntUint64(env napi.Env, value uint64) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return (&Value{kind: napi.ValueTypeBigint, bigint: new(big.Int).SetUint64(value)}).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateBigIntWords(env napi.Env, signBit int, wordCount int, words *uint64) (napi.Value, napi.Status) {
+	e := envOf(env)
+	if wordCount < 0 || (wordCount > 0 && words == nil) {
+		return nil, e.set(napi.StatusInvalidArg)
+	}
+	value := new(big.Int)
+	for _, word := range slices.Backward(unsafe.Slice(words, wordCount)) {
+		value.Lsh(value, 64).Or(value, new(big.Int).SetUint64(word))
+	}
+	if signBit != 0 {
+		value.Neg(value)
+	}
+	return (&Value{kind: napi.ValueTypeBigint, bigint: value}).napiValue(), e.set(napi.StatusOK)
+}
+
+// Lower 64 bits of bigint in two's complement
+func lower64(value *big.Int) uint64 {
+	mask := new(big.Int).SetUint64(math.MaxUint64)
+	return new(big.Int).And(value, mask).Uint64()
+}
+
+func (Backend) GetValueBigIntInt64(env napi.Env, value napi.Value) (int64, bool, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return 0, false, e.set(napi.StatusInvalidArg)
+	} else if v.kind != napi.ValueTypeBigint {
+		return 0, false, e.set(napi.StatusBigintExpected)
+	}
+	return int64(lower64(v.bigint)), v.bigint.IsInt64(), e.set(napi.StatusOK)
+}
+
+func (Backend) GetValueBigIntUint64(env napi.Env, value napi.Value) (uint64, bool, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return 0, false, e.set(napi.StatusInvalidArg)
+	} else if v.kind != napi.ValueTypeBigint {
+		return 0, false, e.set(napi.StatusBigintExpected)
+	}
+	return lower64(v.bigint), v.bigint.IsUint64(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateDate(env napi.Env, value float64) (napi.Value, napi.Status) {
+	e := envOf(env)
+	date := e.newObject(classDate, e.dateProto)
+	date.date = value
+	if math.IsInf(value, 0) || math.Abs(value) > 8.64e15 {
+		date.date = math.NaN()
+	} else if !math.IsNaN(value) {
+		date.date = math.Trunc(value)
+	}
+	return date.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) GetDateValue(env napi.Env, value napi.Value) (float64, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return 0, e.set(napi.StatusInvalidArg)
+	} else if v.object == nil || v.class != classDate {
+		return 0, e.set(napi.StatusDateExpected)
+	}
+	return v.date, e.set(napi.StatusOK)
+}
+
+func (Backend) CreatePromise(env napi.Env) (napi.Value, napi.Deferred, napi.Status) {
+	e := envOf(env)
+	promise := e.newObject(classPromise, e.promiseProto)
+	return promise.napiValue(), napi.Deferred(unsafe.Pointer(promise)), e.set(napi.StatusOK)
+}
+
+func (e *Env) settle(deferred napi.Deferred, result napi.Value, state PromiseState) napi.Status {
+	promise, v := (*Value)(unsafe.Pointer(deferred)), valueOf(result)
+	if promise == nil || v == nil {
+		return e.set(napi.StatusInvalidArg)
+	} else if promise.state != Pending || promise.result != nil {
+		return e.set(napi.StatusOK)
+	}
+	if state == Fulfilled && v.object != nil && v.class == classPromise && v.result != nil {
+		state, v = v.state, v.result // Adopt state of settled promise
+	}
+	promise.state, promise.result = state, v
+	return e.set(napi.StatusOK)
+}
+
+func (Backend) ResolveDeferred(env napi.Env, deferred napi.Deferred, resolution napi.Value) napi.Status {
+	return envOf(env).settle(deferred, resolution, Fulfilled)
+}
+
+func (Backend) RejectDeferred(env napi.Env, deferred napi.Deferred, rejection napi.Value) napi.Status {
+	return envOf(env).settle(deferred, rejection, Rejected)
+}
+
+func (Backend) IsPromise(env napi.Env, value napi.Value) (bool, napi.Status) {
+	return isClass(env, value, classPromise)
+}
+
+func (Backend) IsArray(env napi.Env, value napi.Value) (bool, napi.Status) {
+	return isClass(env, value, classArray)
+}
+
+func (Backend) IsError(env napi.Env, value napi.Value) (bool, napi.Status) {
+	return isClass(env, value, classError)
+}
+
+func (Backend) IsDate(env napi.Env, value napi.Value) (bool, napi.Status) {
+	return isClass(env, value, classDate)
+}
+
+func (Backend) IsArrayBuffer(env napi.Env, value napi.Value) (bool, napi.Status) {
+	return isClass(env, value, classArrayBuffer)
+}
+
+func (Backend) IsDataView(env napi.Env, value napi.Value) (bool, napi.Status) {
+	return isClass(env, value, classDataView)
+}
+
+func (Backend) IsTypedArray(env napi.Env, value napi.Value) (bool, napi.Status) {
+	return isClass(env, value, classTypedArray, classBuffer)
+}
+
+// Node.js Buffer.isBuffer is true to any ArrayBufferView
+func (Backend) IsBuffer(env napi.Env, value napi.Value) (bool, napi.Status) {
+	return isClass(env, value, classTypedArray, classBuffer, classDataView)
+}
+
+func isClass(env napi.Env, value napi.Value, classes ...class) (bool, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return false, e.set(napi.StatusInvalidArg)
+	} else if v.object == nil || v.kind == napi.ValueTypeExternal {
+		return false, e.set(napi.StatusOK)
+	}
+	for _, class := range classes {
+		if v.class == class {
+			return true, e.set(napi.StatusOK)
+		}
+	}
+	return false, e.set(napi.StatusOK)
+}
+
+func (Backend) CreateExternal(env napi.Env, data unsafe.Pointer, finalize napi.Finalize, finalizeHint unsafe.Pointer) (napi.Value, napi.Status) {
+	e := envOf(env)
+	external := e.newObject(classExternal, nil)
+	external.kind, external.external = napi.ValueTypeExternal, data
+	return external.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) GetValueExternal(env napi.Env, value napi.Value) (unsafe.Pointer, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil || v.kind != napi.ValueTypeExternal {
+		return nil, e.set(napi.StatusInvalidArg)
+	}
+	return v.external, e.set(napi.StatusOK)
+}
+
+func (Backend) AdjustExternalMemory(env napi.Env, change int64) (int64, napi.Status) {
+	e := envOf(env)
+	e.adjusted += change
+	return e.adjusted, e.set(napi.StatusOK)
+}
+
+func (Backend) CoerceToBool(env napi.Env, value napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return nil, e.set(napi.StatusInvalidArg)
+	} else if status := e.preamble(); status != napi.StatusOK {
+		return nil, e.set(status)
+	}
+	return e.boolean(e.toBool(v)).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CoerceToNumber(env napi.Env, value napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return nil, e.set(napi.StatusInvalidArg)
+	} else if status := e.preamble(); status != napi.StatusOK {
+		return nil, e.set(status)
+	}
+	switch v.kind {
+	case napi.ValueTypeSymbol:
+		e.throw(e.typeErrorProto, "Cannot convert a Symbol value to a number")
+		return nil, e.set(napi.StatusNumberExpected)
+	case napi.ValueTypeBigint:
+		e.throw(e.typeErrorProto, "Cannot convert a BigInt value to a number")
+		return nil, e.set(napi.StatusNumberExpected)
+	}
+	return e.number(e.toNumber(v)).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CoerceToString(env napi.Env, value napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return nil, e.set(napi.StatusInvalidArg)
+	} else if status := e.preamble(); status != napi.StatusOK {
+		return nil, e.set(status)
+	} else if v.kind == napi.ValueTypeSymbol {
+		e.throw(e.typeErrorProto, "Cannot convert a Symbol value to a string")
+		return nil, e.set(napi.StatusStringExpected)
+	}
+	return e.string(e.toString(v)).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CoerceToObject(env napi.Env, value napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return nil, e.set(napi.StatusInvalidArg)
+	} else if status := e.preamble(); status != napi.StatusOK {
+		return nil, e.set(status)
+	}
+	switch v.kind {
+	case napi.ValueTypeUndefined, napi.ValueTypeNull:
+		e.throw(e.typeErrorProto, "Cannot convert undefined or null to object")
+		return nil, e.set(napi.StatusObjectExpected)
+	case napi.ValueTypeObject, napi.ValueTypeFunction, napi.ValueTypeExternal:
+		return v.napiValue(), e.set(napi.StatusOK)
+	}
+	wrapper := e.newObject(classWrapper, e.objectProto)
+	wrapper.primitive = v
+	return wrapper.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateArrayBuffer(env napi.Env, length int) (napi.Value, *byte, napi.Status) {
+	e := envOf(env)
+	if length < 0 {
+		return nil, nil, e.set(napi.StatusInvalidArg)
+	}
+	buffer := e.newArrayBuffer(make([]byte, length))
+	return buffer.napiValue(), dataPointer(*buffer.data), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateExternalArrayBuffer(env napi.Env, data unsafe.Pointer, length int, finalize napi.Finalize, finalizeHint unsafe.Pointer) (napi.Value, napi.Status) {
+	e := envOf(env)
+	if length < 0 || (length > 0 && data == nil) {
+		return nil, e.set(napi.StatusInvalidArg)
+	}
+	return e.newArrayBuffer(unsafe.Slice((*byte)(data), length)).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) GetArrayBufferInfo(env napi.Env, value napi.Value) (*byte, int, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil || v.object == nil || v.class != classArrayBuffer {
+		return nil, 0, e.set(napi.StatusInvalidArg)
+	}
+	return dataPointer(*v.data), len(*v.data), e.set(napi.StatusOK)
+}
+
+func (Backend) IsDetachedArrayBuffer(env napi.Env, value napi.Value) (bool, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return false, e.set(napi.StatusInvalidArg)
+	}
+	return v.object != nil && v.class == classArrayBuffer && v.detached, e.set(napi.StatusOK)
+}
+
+func (Backend) DetachArrayBuffer(env napi.Env, value napi.Value) napi.Status {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return e.set(napi.StatusInvalidArg)
+	} else if v.object == nil || v.class != classArrayBuffer {
+		return e.set(napi.StatusArraybufferExpected)
+	}
+	v.detached, *v.data = true, nil
+	return e.set(napi.StatusOK)
+}
+
+func (Backend) CreateBuffer(env napi.Env, length int) (napi.Value, napi.Status) {
+	e := envOf(env)
+	if length < 0 {
+		return nil, e.set(napi.StatusInvalidArg)
+	}
+	return e.newView(classBuffer, e.bufferProto, e.newArrayBuffer(make([]byte, length)), napi.TypedArrayUint8Array, 0, length).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateBufferCopy(env napi.Env, data []byte) (napi.Value, napi.Status) {
+	e := envOf(env)
+	return e.newView(classBuffer, e.bufferProto, e.newArrayBuffer(append([]byte{}, data...)), napi.TypedArrayUint8Array, 0, len(data)).napiValue(), e.set(napi.StatusOK)
+}
+
+func (b Backend) GetBufferInfo(env napi.Env, value napi.Value) (*byte, int, napi.Status) {
+	data, status := b.GetBufferInfoData(env, value)
+	return dataPointer(data), len(data), status
+}
+
+func (b Backend) GetBufferInfoSize(env napi.Env, value napi.Value) (int, napi.Status) {
+	data, status := b.GetBufferInfoData(env, value)
+	return len(data), status
+}
+
+func (Backend) GetBufferInfoData(env napi.Env, value napi.Value) ([]byte, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil || !v.isView() {
+		return nil, e.set(napi.StatusInvalidArg)
+	}
+	return v.bytes(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateTypedArray(env napi.Env, arrayType napi.TypedArrayType, length int, arrayBuffer napi.Value, byteOffset int) (napi.Value, napi.Status) {
+	e := envOf(env)
+	buffer := valueOf(arrayBuffer)
+	size, ok := typedArraySize[arrayType]
+	if buffer == nil || !ok || length < 0 || byteOffset < 0 {
+		return nil, e.set(napi.StatusInvalidArg)
+	} else if status := e.preamble(); status != napi.StatusOK {
+		return nil, e.set(status)
+	} else if buffer.object == nil || buffer.class != classArrayBuffer {
+		return nil, e.set(napi.StatusInvalidArg)
+	} else if byteOffset%size != 0 {
+		e.throw(e.rangeErrorProto, "start offset of "+typedArrayName(arrayType)+" should be a multiple of "+strconv.Itoa(size))
+		return nil, e.set(napi.StatusPendingException)
+	} else if byteOffset+length*size > len(*buffer.data) {
+		e.throw(e.rangeErrorProto, "Invalid typed array length")
+		return nil, e.set(napi.StatusPendingException)
+	}
+	return e.newView(classTypedArray, e.typedArrayProto, buffer, arrayType, byteOffset, length).napiValue(), e.set(napi.StatusOK)
+}
+
+func typedArrayName(arrayType napi.TypedArrayType) string {
+	switch arrayType {
+	case napi.TypedArrayInt8Array:
+		return "Int8Array"
+	case napi.TypedArrayUint8Array:
+		return "Uint8Array"
+	case napi.TypedArrayUint8ClampedArray:
+		return "Uint8ClampedArray"
+	case napi.TypedArrayInt16Array:
+		return "Int16Array"
+	case napi.TypedArrayUint16Array:
+		return "Uint16Array"
+	case napi.TypedArrayInt32Array:
+		return "Int32Array"
+	case napi.TypedArrayUint32Array:
+		return "Uint32Array"
+	case napi.TypedArrayFloat32Array:
+		return "Float32Array"
+	case napi.TypedArrayFloat64Array:
+		return "Float64Array"
+	case napi.TypedArrayBigInt64Array:
+		return "BigInt64Array"
+	case napi.TypedArrayBigUint64Array:
+		return "BigUint64Array"
+	}
+	return "TypedArray"
+}
+
+func (Backend) GetTypedArrayInfo(env napi.Env, value napi.Value) (napi.TypedArrayType, int, *byte, napi.Value, int, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil || v.object == nil || (v.class != classTypedArray && v.class != classBuffer) {
+		return 0, 0, nil, nil, 0, e.set(napi.StatusInvalidArg)
+	}
+	return v.arrayType, v.size, dataPointer(v.bytes()), v.buffer.napiValue(), v.offset, e.set(napi.StatusOK)
+}
+
+func (Backend) CreateDataView(env napi.Env, length int, arrayBuffer napi.Value, byteOffset int) (napi.Value, napi.Status) {
+	e := envOf(env)
+	buffer := valueOf(arrayBuffer)
+	if buffer == nil || length < 0 || byteOffset < 0 {
+		return nil, e.set(napi.StatusInvalidArg)
+	} else if status := e.preamble(); status != napi.StatusOK {
+		return nil, e.set(status)
+	} else if buffer.object == nil || buffer.class != classArrayBuffer {
+		return nil, e.set(napi.StatusInvalidArg)
+	} else if byteOffset+length > len(*buffer.data) {
+		e.throw(e.rangeErrorProto, "byte_offset + byte_length should be less than or equal to the size in bytes of the array passed in")
+		return nil, e.set(napi.StatusPendingException)
+	}
+	return e.newView(classDataView, e.dataViewProto, buffer, 0, byteOffset, length).napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) GetDataViewInfo(env napi.Env, value napi.Value) (int, *byte, napi.Value, int, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil || v.object == nil || v.class != classDataView {
+		return 0, nil, nil, 0, e.set(napi.StatusInvalidArg)
+	}
+	return v.size, dataPointer(v.bytes()), v.buffer.napiValue(), v.offset, e.set(napi.StatusOK)
+}
+
+func (Backend) GetArrayLength(env napi.Env, value napi.Value) (int, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil {
+		return 0, e.set(napi.StatusInvalidArg)
+	} else if v.object == nil || v.class != classArray {
+		return 0, e.set(napi.StatusArrayExpected)
+	}
+	return v.length, e.set(napi.StatusOK)
+}
+
+func (Backend) GetPrototype(env napi.Env, value napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	v, status := e.objectArg(value)
+	if status != napi.StatusOK {
+		return nil, e.set(status)
+	} else if v.proto == nil {
+		return e.null.napiValue(), e.set(napi.StatusOK)
+	}
+	return v.proto.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) SetProperty(env napi.Env, object, k, value napi.Value) napi.Status {
+	e := envOf(env)
+	obj, status := e.objectArg(object)
+	if status != napi.StatusOK || valueOf(k) == nil || valueOf(value) == nil {
+		return e.set(max(status, napi.StatusInvalidArg))
+	} else if status = e.preamble(); status != napi.StatusOK {
+		return e.set(status)
+	}
+	return e.set(e.setProperty(obj, e.toKey(valueOf(k)), valueOf(value)))
+}
+
+func (Backend) GetProperty(env napi.Env, object, k napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	obj, status := e.objectArg(object)
+	if status != napi.StatusOK || valueOf(k) == nil {
+		return nil, e.set(max(status, napi.StatusInvalidArg))
+	} else if status = e.preamble(); status != napi.StatusOK {
+		return nil, e.set(status)
+	}
+	result := e.getProperty(obj, e.toKey(valueOf(k)))
+	if e.exception != nil {
+		return nil, e.set(napi.StatusPendingException)
+	}
+	return result.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) HasProperty(env napi.Env, object, k napi.Value) (bool, napi.Status) {
+	e := envOf(env)
+	obj, status := e.objectArg(object)
+	if status != napi.StatusOK || valueOf(k) == nil {
+		return false, e.set(max(status, napi.StatusInvalidArg))
+	} else if status = e.preamble(); status != napi.StatusOK {
+		return false, e.set(status)
+	}
+	return obj.lookup(e.toKey(valueOf(k))) != nil || (obj.class == classArray && e.toKey(valueOf(k)).name == "length"), e.set(napi.StatusOK)
+}
+
+func (Backend) HasOwnProperty(env napi.Env, object, k napi.Value) (bool, napi.Status) {
+	e := envOf(env)
+	obj, status := e.objectArg(object)
+	if status != napi.StatusOK || valueOf(k) == nil {
+		return false, e.set(max(status, napi.StatusInvalidArg))
+	} else if status = e.preamble(); status != napi.StatusOK {
+		return false, e.set(status)
+	} else if kind := valueOf(k).kind; kind != napi.ValueTypeString && kind != napi.ValueTypeSymbol {
+		return false, e.set(napi.StatusNameExpected)
+	}
+	_, ok := obj.props[e.toKey(valueOf(k))]
+	return ok, e.set(napi.StatusOK)
+}
+
+func (Backend) DeleteProperty(env napi.Env, object, k napi.Value) (bool, napi.Status) {
+	e := envOf(env)
+	obj, status := e.objectArg(object)
+	if status != napi.StatusOK || valueOf(k) == nil {
+		return false, e.set(max(status, napi.StatusInvalidArg))
+	} else if status = e.preamble(); status != napi.StatusOK {
+		return false, e.set(status)
+	}
+	return obj.remove(e.toKey(valueOf(k))), e.set(napi.StatusOK)
+}
+
+func (b Backend) SetNamedProperty(env napi.Env, object napi.Value, name string, value napi.Value) napi.Status {
+	return b.SetProperty(env, object, envOf(env).string(name).napiValue(), value)
+}
+
+func (b Backend) GetNamedProperty(env napi.Env, object napi.Value, name string) (napi.Value, napi.Status) {
+	return b.GetProperty(env, object, envOf(env).string(name).napiValue())
+}
+
+func (b Backend) HasNamedProperty(env napi.Env, object napi.Value, name string) (bool, napi.Status) {
+	return b.HasProperty(env, object, envOf(env).string(name).napiValue())
+}
+
+func indexKey(env napi.Env, index int) napi.Value {
+	return envOf(env).string(strconv.Itoa(index)).napiValue()
+}
+
+func (b Backend) SetElement(env napi.Env, object napi.Value, index int, value napi.Value) napi.Status {
+	return b.SetProperty(env, object, indexKey(env, index), value)
+}
+
+func (b Backend) GetElement(env napi.Env, object napi.Value, index int) (napi.Value, napi.Status) {
+	return b.GetProperty(env, object, indexKey(env, index))
+}
+
+func (b Backend) HasElement(env napi.Env, object napi.Value, index int) (bool, napi.Status) {
+	return b.HasProperty(env, object, indexKey(env, index))
+}
+
+func (b Backend) DeleteElement(env napi.Env, object napi.Value, index int) (bool, napi.Status) {
+	return b.DeleteProperty(env, object, indexKey(env, index))
+}
+
+func (b Backend) GetPropertyNames(env napi.Env, object napi.Value) (napi.Value, napi.Status) {
+	return b.GetAllPropertyNames(env, object, napi.KeyIncludePrototypes, napi.KeyEnumerable|napi.KeySkipSymbols, napi.KeyNumbersToStrings)
+}
+
+func (Backend) GetAllPropertyNames(env napi.Env, object napi.Value, keyMode napi.KeyCollectionMode, keyFilter napi.KeyFilter, keyConversion napi.KeyConversion) (napi.Value, napi.Status) {
+	e := envOf(env)
+	obj, status := e.objectArg(object)
+	if status != napi.StatusOK {
+		return nil, e.set(status)
+	} else if status = e.preamble(); status != napi.StatusOK {
+		return nil, e.set(status)
+	}
+
+	result, seen := e.newObject(classArray, e.arrayProto), map[key]bool{}
+	for current := obj; current != nil && current.object != nil; current = current.proto {
+		for _, k := range current.ownKeys() {
+			prop := current.props[k]
+			switch {
+			case seen[k]:
+				continue
+			case k.symbol != nil && keyFilter&napi.KeySkipSymbols != 0, k.symbol == nil && keyFilter&napi.KeySkipStrings != 0:
+				continue
+			case keyFilter&napi.KeyEnumerable != 0 && !prop.enumerable,
+				keyFilter&napi.KeyWritable != 0 && !prop.writable,
+				keyFilter&napi.KeyConfigurable != 0 && !prop.configurable:
+				continue
+			}
+			seen[k] = true
+			name := e.string(k.name)
+			if k.symbol != nil {
+				name = k.symbol
+			} else if index, ok := arrayIndex(k); ok && keyConversion == napi.KeyKeepNumbers {
+				name = e.number(float64(index))
+			}
+			result.define(key{name: strconv.Itoa(result.length)}, &property{value: name, enumerable: true, writable: true, configurable: true})
+		}
+		if keyMode == napi.KeyOwnOnly {
+			break
+		}
+	}
+	return result.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) DefineProperties(env napi.Env, object napi.Value, properties []napi.PropertyDescriptor) napi.Status {
+	e := envOf(env)
+	obj, status := e.objectArg(object)
+	if status != napi.StatusOK {
+		return e.set(status)
+	} else if status = e.preamble(); status != napi.StatusOK {
+		return e.set(status)
+	}
+	for _, descriptor := range properties {
+		k := key{name: descriptor.Utf8name}
+		if descriptor.Utf8name == "" {
+			name := valueOf(descriptor.Name)
+			if name == nil || (name.kind != napi.ValueTypeString && name.kind != napi.ValueTypeSymbol) {
+				return e.set(napi.StatusNameExpected)
+			}
+			k = e.toKey(name)
+		}
+
+		prop := &property{
+			enumerable:   descriptor.Attributes&napi.Enumerable != 0,
+			configurable: descriptor.Attributes&napi.Configurable != 0,
+		}
+		switch {
+		case descriptor.Getter != nil || descriptor.Setter != nil:
+			if descriptor.Getter != nil {
+				prop.getter = e.newFunction(k.name, descriptor.Getter)
+			}
+			if descriptor.Setter != nil {
+				prop.setter = e.newFunction(k.name, descriptor.Setter)
+			}
+		case descriptor.Method != nil:
+			prop.value, prop.writable = e.newFunction(k.name, descriptor.Method), descriptor.Attributes&napi.Writable != 0
+		default:
+			prop.value, prop.writable = valueOf(descriptor.Value), descriptor.Attributes&napi.Writable != 0
+			if prop.value == nil {
+				prop.value = e.undefined
+			}
+		}
+		obj.define(k, prop)
+	}
+	return e.set(napi.StatusOK)
+}
+
+func (Backend) ObjectFreeze(env napi.Env, object napi.Value) napi.Status {
+	e := envOf(env)
+	obj, status := e.objectArg(object)
+	if status != napi.StatusOK {
+		return e.set(status)
+	}
+	obj.extensible = false
+	for _, prop := range obj.props {
+		prop.configurable = false
+		if prop.getter == nil && prop.setter == nil {
+			prop.writable = false
+		}
+	}
+	return e.set(napi.StatusOK)
+}
+
+func (Backend) ObjectSeal(env napi.Env, object napi.Value) napi.Status {
+	e := envOf(env)
+	obj, status := e.objectArg(object)
+	if status != napi.StatusOK {
+		return e.set(status)
+	}
+	obj.extensible = false
+	for _, prop := range obj.props {
+		prop.configurable = false
+	}
+	return e.set(napi.StatusOK)
+}
+
+func (Backend) StrictEquals(env napi.Env, lhs, rhs napi.Value) (bool, napi.Status) {
+	e := envOf(env)
+	a, b := valueOf(lhs), valueOf(rhs)
+	if a == nil || b == nil {
+		return false, e.set(napi.StatusInvalidArg)
+	}
+	return strictEquals(a, b), e.set(napi.StatusOK)
+}
+
+func (Backend) InstanceOf(env napi.Env, object, constructor napi.Value) (bool, napi.Status) {
+	e := envOf(env)
+	obj, fn := valueOf(object), valueOf(constructor)
+	if obj == nil || fn == nil {
+		return false, e.set(napi.StatusInvalidArg)
+	} else if status := e.preamble(); status != napi.StatusOK {
+		return false, e.set(status)
+	} else if fn.kind != napi.ValueTypeFunction {
+		e.throw(e.typeErrorProto, "Constructor must be a function")
+		return false, e.set(napi.StatusFunctionExpected)
+	} else if obj.object == nil {
+		return false, e.set(napi.StatusOK)
+	}
+	proto := e.getProperty(fn, key{name: "prototype"})
+	for current := obj.proto; current != nil; current = current.proto {
+		if current == proto {
+			return true, e.set(napi.StatusOK)
+		}
+	}
+	return false, e.set(napi.StatusOK)
+}
+
+func (Backend) GetCbInfo(env napi.Env, info napi.CallbackInfo) (napi.GetCbInfoResult, napi.Status) {
+	e := envOf(env)
+	call := (*callbackInfo)(unsafe.Pointer(info))
+	if call == nil {
+		return napi.GetCbInfoResult{}, e.set(napi.StatusInvalidArg)
+	}
+	result := napi.GetCbInfoResult{This: call.this.napiValue(), Args: make([]napi.Value, len(call.args))}
+	for index, arg := range call.args {
+		result.Args[index] = arg.napiValue()
+	}
+	return result, e.set(napi.StatusOK)
+}
+
+func (Backend) GetNewTarget(env napi.Env, info napi.CallbackInfo) (napi.Value, napi.Status) {
+	e := envOf(env)
+	call := (*callbackInfo)(unsafe.Pointer(info))
+	if call == nil {
+		return nil, e.set(napi.StatusInvalidArg)
+	} else if call.newTarget == nil {
+		return nil, e.set(napi.StatusOK)
+	}
+	return call.newTarget.napiValue(), e.set(napi.StatusOK)
+}
+
+func valuesOf(argc int, argv []napi.Value) ([]*Value, bool) {
+	if argc > len(argv) {
+		return nil, false
+	}
+	args := make([]*Value, argc)
+	for index := range args {
+		if args[index] = valueOf(argv[index]); args[index] == nil {
+			return nil, false
+		}
+	}
+	return args, true
+}
+
+func (Backend) CallFunction(env napi.Env, recv napi.Value, fn napi.Value, argc int, argv []napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	this, function := valueOf(recv), valueOf(fn)
+	args, ok := valuesOf(argc, argv)
+	if this == nil || function == nil || !ok {
+		return nil, e.set(napi.StatusInvalidArg)
+	}
+	result, status := e.call(this, function, args, nil)
+	if status != napi.StatusOK {
+		return nil, e.set(status)
+	}
+	return result.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) NewInstance(env napi.Env, constructor napi.Value, argc int, argv []napi.Value) (napi.Value, napi.Status) {
+	e := envOf(env)
+	fn := valueOf(constructor)
+	args, ok := valuesOf(argc, argv)
+	if fn == nil || !ok {
+		return nil, e.set(napi.StatusInvalidArg)
+	} else if fn.kind != napi.ValueTypeFunction {
+		return nil, e.set(napi.StatusFunctionExpected)
+	}
+
+	proto := e.getProperty(fn, key{name: "prototype"})
+	if proto.object == nil {
+		proto = e.objectProto
+	}
+	this := e.newObject(classObject, proto)
+	result, status := e.call(this, fn, args, fn)
+	if status != napi.StatusOK {
+		return nil, e.set(status)
+	} else if result.object != nil {
+		return result.napiValue(), e.set(napi.StatusOK)
+	}
+	return this.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) CreateReference(env napi.Env, value napi.Value, initialRefcount int) (napi.Reference, napi.Status) {
+	e := envOf(env)
+	v := valueOf(value)
+	if v == nil || initialRefcount < 0 {
+		return napi.Reference{}, e.set(napi.StatusInvalidArg)
+	}
+	return napi.Reference{Ref: unsafe.Pointer(&reference{value: v, count: initialRefcount})}, e.set(napi.StatusOK)
+}
+
+func (Backend) DeleteReference(env napi.Env, ref napi.Reference) napi.Status {
+	e := envOf(env)
+	if ref.Ref == nil {
+		return e.set(napi.StatusInvalidArg)
+	}
+	return e.set(napi.StatusOK)
+}
+
+func (Backend) ReferenceRef(env napi.Env, ref napi.Reference) (int, napi.Status) {
+	e := envOf(env)
+	r := (*reference)(ref.Ref)
+	if r == nil {
+		return 0, e.set(napi.StatusInvalidArg)
+	}
+	r.count++
+	return r.count, e.set(napi.StatusOK)
+}
+
+func (Backend) ReferenceUnref(env napi.Env, ref napi.Reference) (int, napi.Status) {
+	e := envOf(env)
+	r := (*reference)(ref.Ref)
+	if r == nil || r.count == 0 {
+		return 0, e.set(napi.StatusGenericFailure)
+	}
+	r.count--
+	return r.count, e.set(napi.StatusOK)
+}
+
+func (Backend) GetReferenceValue(env napi.Env, ref napi.Reference) (napi.Value, napi.Status) {
+	e := envOf(env)
+	r := (*reference)(ref.Ref)
+	if r == nil {
+		return nil, e.set(napi.StatusInvalidArg)
+	}
+	return r.value.napiValue(), e.set(napi.StatusOK)
+}
+
+func (Backend) Wrap(env napi.Env, jsObject napi.Value, nativeObject unsafe.Pointer, finalize napi.Finalize, finalizeHint unsafe.Pointer) napi.Status {
+	e := envOf(env)
+	obj, status := e.objectArg(jsObject)
+	if status != napi.StatusOK {
+		return e.set(status)
+	} else if obj.isWrapped {
+		return e.set(napi.StatusInvalidArg)
+	}
+	obj.wrapped, obj.isWrapped = nativeObject, true
+	return e.set(napi.StatusOK)
+}
+
+func (Backend) Unwrap(env napi.Env, jsObject napi.Value) (unsafe.Pointer, napi.Status) {
+	e := envOf(env)
+	obj, status := e.objectArg(jsObject)
+	if status != napi.StatusOK {
+		return nil, e.set(status)
+	} else if !obj.isWrapped {
+		return nil, e.set(napi.StatusInvalidArg)
+	}
+	return obj.wrapped, e.set(napi.StatusOK)
+}
+
+func (Backend) RemoveWrap(env napi.Env, jsObject napi.Value) napi.Status {
+	e := envOf(env)
+	obj, status := e.objectArg(jsObject)
+	if status != napi.StatusOK {
+		return e.set(status)
+	} else if !obj.isWrapped {
+		return e.set(napi.StatusInvalidArg)
+	}
+	obj.wrapped, obj.isWrapped = nil, false
+	return e.set(napi.StatusOK)
+}
+
+func (Backend) OpenHandleScope(env napi.Env) (napi.HandleScope, napi.Status) {
+	return napi.HandleScope{}, envOf(env).set(napi.StatusOK)
+}
+
+func (Backend) CloseHandleScope(env napi.Env, scope napi.HandleScope) napi.Status {
+	return envOf(env).set(napi.StatusOK)
+}
+
+func (Backend) OpenEscapableHandleScope(env napi.Env) (napi.EscapableHandleScope, napi.Status) {
+	return napi.EscapableHandleScope{}, envOf(env).set(napi.StatusOK)
+}
+
+func (Backend) CloseEscapableHandleScope(env napi.Env, scope napi.EscapableHandleScope) napi.Status {
+	return envOf(env).set(napi.StatusOK)
+}
+
+func (Backend) EscapeHandle(env napi.Env, scope napi.EscapableHandleScope, escapee napi.Value) (napi.Value, napi.Status) {
+	return escapee, envOf(env).set(napi.StatusOK)
+}
+
+func (Backend) CloseCallbackScope(env napi.Env, scope napi.CallbackScope) napi.Status {
+	return envOf(env).set(napi.StatusOK)
+}
@@ -0,0 +1,242 @@
+package fake_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/bindgen/testdata/bindtest"
+)
+
+// Describe Javascript value with type, objects with sorted keys, to compare values created by reflection and bind
+func snapshot(t testing.TB, value napi.ValueType) string {
+	t.Helper()
+	switch typeOf := typeOf(t, value); typeOf {
+	case napi.TypeUndefined, napi.TypeNull:
+		return typeOf.String()
+	case napi.TypeBoolean, napi.TypeNumber, napi.TypeString, napi.TypeBigInt:
+		return typeOf.String() + "(" + describe(t, value) + ")"
+	case napi.TypeBuffer, napi.TypeTypedArray:
+		data, err := napi.ToBuffer(value).Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return "Buffer(" + hex.EncodeToString(data) + ")"
+	case napi.TypeDate:
+		date, err := napi.ToDate(value).Time()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return "Date(" + date.UTC().Format(time.RFC3339) + ")"
+	case napi.TypeArray:
+		arr := napi.ToArray(value)
+		size, err := arr.Length()
+		if err != nil {
+			t.Fatal(err)
+		}
+		items := make([]string, size)
+		for index := range size {
+			item, err := arr.Get(index)
+			if err != nil {
+				t.Fatal(err)
+			}
+			items[index] = snapshot(t, item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case napi.TypeObject:
+		var items []string
+		for key, item := range napi.ToObject(value).Seq() {
+			items = append(items, key+": "+snapshot(t, item))
+		}
+		slices.Sort(items)
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		return typeOf.String()
+	}
+}
+
+// Call function and return snapshot of result or exception thrown
+func callSnapshot(t testing.TB, env napi.EnvType, fn *napi.Function, this napi.ValueType, args []napi.ValueType) string {
+	t.Helper()
+	res, err := fn.CallWithGlobal(this, args...)
+	if errors.Is(err, napi.ErrPendingException) {
+		exception := thrown(t, env)
+		code := "" // Go errors have stack trace in code
+		if value, _ := exception.Get("code"); typeOf(t, value) == napi.TypeString && strings.HasPrefix(describe(t, value), "ERR_") {
+			code = describe(t, value) + ": "
+		}
+		return "throw " + code + propertyString(t, exception, "message")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	return snapshot(t, res)
+}
+
+// Generated callbacks decode arguments and encode results same of napi.GoFuncOf
+func TestBindParity(t *testing.T) {
+	date := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	user := map[string]any{
+		"name": "Ana", "age": 30, "email": "ana@example.com", "address": map[string]any{"street": "Main"},
+		"tags": []string{"go"}, "avatar": []byte{0xca, 0xfe}, "balance": int64(-5), "level": 2, "color": "#102030",
+		"created": date, "metadata": map[string]string{"a": "b"}, "Skipped": "x",
+	}
+	tests := []struct {
+		name     string
+		function any
+		callback napi.Callback
+		args     [][]any
+	}{
+		{"numbers", bindtest.Numbers, bindtest.NumbersCallback, [][]any{
+			{1, -2, 3, 4, 0.5, 1.25},
+			{1, 2, 3, 4, 5, int64(6) << 40},
+			{1.9, 2, 3, 4, 5, 6},
+			{1, 2, 3},
+			{1, 2, 3, 4, 5, "6"},
+		}},
+		{"big", bindtest.Big, bindtest.BigCallback, [][]any{
+			{int64(-1) << 40, uint64(1) << 63},
+			{1, 2},
+			{"1", 2},
+		}},
+		{"optional", bindtest.Greet, bindtest.GreetCallback, [][]any{
+			{"Ana"},
+			{"Ana", "Hi"},
+			{"Ana", nil, 2},
+			{"Ana", "Hi", 3},
+			{"Ana", 1},
+			{"Ana", "Hi", "3"},
+			{},
+		}},
+		{"variadic", bindtest.Sum, bindtest.SumCallback, [][]any{
+			{1},
+			{1, 2, 3.5},
+			{1, 2, "3"},
+			{},
+		}},
+		{"bytes and map", bindtest.Reverse, bindtest.ReverseCallback, [][]any{
+			{[]byte{1, 2, 3}, map[string]bool{"a": true}},
+			{[]int{1, 2}, map[string]bool{}},
+			{"abc", map[string]bool{}},
+			{[]byte{1}, map[string]any{"a": 1}},
+		}},
+		{"struct", bindtest.Rename, bindtest.RenameCallback, [][]any{
+			{user, "Bia"},
+			{map[string]any{"name": "Ana"}, "Bia"},
+			{user, ""},
+			{"user", "Bia"},
+			{map[string]any{"name": 1}, "Bia"},
+		}},
+		{"error only", bindtest.Validate, bindtest.ValidateCallback, [][]any{
+			{user},
+			{map[string]any{}},
+			{},
+			{nil},
+		}},
+		{"time and text", bindtest.Tomorrow, bindtest.TomorrowCallback, [][]any{
+			{date, "#010203", 1},
+			{date, "red", 1},
+			{"2025-01-02", "#010203", 1},
+		}},
+		{"callback info", bindtest.Count, bindtest.CountCallback, [][]any{
+			{[]string{"a", "b"}, "extra"},
+			{},
+		}},
+		{"no results", bindtest.Nothing, bindtest.NothingCallback, [][]any{{}, {1}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := newEnv(t)
+			reflection := goFunc(t, env, test.function)
+			generated, err := napi.CreateFunction(env, test.name, test.callback)
+			if err != nil {
+				t.Fatal(err)
+			}
+			undefined, err := env.Undefined()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, args := range test.args {
+				want := callSnapshot(t, env, reflection, undefined, jsArgs(t, env, args...))
+				if got := callSnapshot(t, env, generated, undefined, jsArgs(t, env, args...)); got != want {
+					t.Errorf("call with %v:\ngenerated  %s\nreflection %s", args, got, want)
+				}
+			}
+		})
+	}
+
+	t.Run("this", func(t *testing.T) {
+		env := newEnv(t)
+		this, err := napi.ValueOf(env, map[string]string{"name": "Ana"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		generated, err := napi.CreateFunction(env, "describe", bindtest.DescribeCallback)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, args := range [][]any{{1}, {"a"}, {}} {
+			want := callSnapshot(t, env, goFunc(t, env, bindtest.Describe), this, jsArgs(t, env, args...))
+			if got := callSnapshot(t, env, generated, this, jsArgs(t, env, args...)); got != want {
+				t.Errorf("call with %v:\ngenerated  %s\nreflection %s", args, got, want)
+			}
+		}
+	})
+}
+
+// Struct without generated methods, converted by reflection
+type reflectUser bindtest.User
+
+// Generated MarshalNapi and UnmarshalNapi convert same of napi.ValueOf and napi.ValueFrom
+func TestBindStructParity(t *testing.T) {
+	email := "ana@example.com"
+	users := []bindtest.User{
+		{},
+		{Name: "Ana", Age: 30, Email: &email, Address: &bindtest.Address{Street: "Main", Number: 10}, Tags: []string{"go"},
+			Avatar: []byte{0xca, 0xfe}, Balance: -5, Level: 2, Color: bindtest.Color{R: 1, G: 2, B: 3},
+			Created: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), Metadata: map[string]string{"a": "b"}, Skipped: "x"},
+		{Name: "Bia", Address: &bindtest.Address{}, Tags: []string{}, Avatar: []byte{}, Friends: []*bindtest.Address{nil, {Street: "Side"}}},
+	}
+	for index, user := range users {
+		t.Run(fmt.Sprint(index), func(t *testing.T) {
+			env := newEnv(t)
+			want, err := napi.ValueOf(env, reflectUser(user))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := user.MarshalNapi(env)
+			if err != nil {
+				t.Fatal(err)
+			} else if snapshot(t, got) != snapshot(t, want) {
+				t.Errorf("MarshalNapi:\ngenerated  %s\nreflection %s", snapshot(t, got), snapshot(t, want))
+			}
+
+			var generated bindtest.User
+			var reflection reflectUser
+			if err := generated.UnmarshalNapi(want); err != nil {
+				t.Fatal(err)
+			} else if err := napi.ValueFrom(want, &reflection); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(reflectUser(generated), reflection) {
+				t.Errorf("UnmarshalNapi:\ngenerated  %#v\nreflection %#v", generated, reflection)
+			}
+		})
+	}
+
+	t.Run("not object", func(t *testing.T) {
+		env := newEnv(t)
+		value := jsArgs(t, env, "user")[0]
+		var generated bindtest.User
+		var reflection reflectUser
+		errGenerated, errReflection := generated.UnmarshalNapi(value), napi.ValueFrom(value, &reflection)
+		if errGenerated == nil || errReflection == nil || errGenerated.Error() != errReflection.Error() {
+			t.Errorf("UnmarshalNapi error %v, ValueFrom error %v", errGenerated, errReflection)
+		}
+	})
+}
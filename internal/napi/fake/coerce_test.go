@@ -0,0 +1,189 @@
+package fake_test
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	internalNapi "sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+func TestValueFromCoerce(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any // Go value converted to Javascript with ValueOf
+		want  any
+	}{
+		{"string to int", "42", 42},
+		{"string with spaces to int", " 42 ", 42},
+		{"hex string to int", "0x10", 16},
+		{"empty string to int", "", 0},
+		{"string to float", "1.5", 1.5},
+		{"bool to int", true, 1},
+		{"array to int", []int{7}, 7},
+		{"number to string", 1.5, "1.5"},
+		{"integer to string", 42, "42"},
+		{"bool to string", false, "false"},
+		{"array to string", []any{1, "a", true}, "1,a,true"},
+		{"object to string", map[string]int{}, "[object Object]"},
+		{"empty string to bool", "", false},
+		{"string to bool", "false", true},
+		{"zero to bool", 0, false},
+		{"number to bool", -1, true},
+		{"object to bool", map[string]int{}, true},
+		{"undefined to int", nil, 0},
+		{"undefined to string", nil, ""},
+		{"undefined to bool", nil, false},
+		{"struct field", map[string]any{"name": 1, "age": "30", "admin": 1}, user{Name: "1", Age: 30, Admin: true}},
+		{"slice items", []any{"1", 2, true}, []int{1, 2, 1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := newEnv(t)
+			value, err := napi.ValueOf(env, test.value)
+			if err != nil {
+				t.Fatal(err)
+			} else if value == nil {
+				if value, err = env.Undefined(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			got := reflect.New(reflect.TypeOf(test.want))
+			if err := napi.ValueFromCoerce(value, got.Interface()); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.Elem().Interface(), test.want) {
+				t.Errorf("ValueFromCoerce(%#v) = %#v, want %#v", test.value, got.Elem(), test.want)
+			}
+		})
+	}
+}
+
+func TestValueFromCoerceSymbol(t *testing.T) {
+	env := newEnv(t)
+	napiValue, status := internalNapi.CreateSymbol(env.NapiValue(), nil)
+	if status != internalNapi.StatusOK {
+		t.Fatal(status)
+	}
+	symbol := napi.N_APIValue(env, napiValue)
+	var str string
+	if err := napi.ValueFromCoerce(symbol, &str); err == nil {
+		t.Errorf("symbol decoded to %q, want error", str)
+	}
+}
+
+func TestCoerce(t *testing.T) {
+	env := newEnv(t)
+	value, err := napi.ValueOf(env, []any{"1", 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	str, err := napi.CoerceString(value)
+	if err != nil {
+		t.Fatal(err)
+	} else if got, err := str.Utf8Value(); err != nil || got != "1,2" {
+		t.Errorf("CoerceString = %q, %v, want \"1,2\"", got, err)
+	}
+
+	number, err := napi.CoerceNumber(value)
+	if err != nil {
+		t.Fatal(err)
+	} else if got, err := number.Float(); err != nil || !math.IsNaN(got) {
+		t.Errorf("CoerceNumber = %v, %v, want NaN", got, err)
+	}
+
+	boolean, err := napi.CoerceBoolean(value)
+	if err != nil {
+		t.Fatal(err)
+	} else if got, err := boolean.Value(); err != nil || !got {
+		t.Errorf("CoerceBoolean = %v, %v, want true", got, err)
+	}
+
+	object, err := napi.CoerceObject(str)
+	if err != nil {
+		t.Fatal(err)
+	} else if typeOf := typeOf(t, object); typeOf != napi.TypeObject {
+		t.Errorf("CoerceObject type = %s, want object", typeOf)
+	}
+}
+
+// Number to string and back to number is same number, string of number to bool is true
+func FuzzCoerceNumber(f *testing.F) {
+	f.Add(0.0)
+	f.Add(1.5)
+	f.Add(-1e21)
+	f.Add(1e-7)
+	f.Add(math.MaxFloat64)
+	f.Add(math.SmallestNonzeroFloat64)
+	f.Fuzz(func(t *testing.T, number float64) {
+		env := newEnv(t)
+		value, err := napi.CreateNumber(env, number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var str string
+		if err := napi.ValueFromCoerce(value, &str); err != nil {
+			t.Fatal(err)
+		}
+		strValue, err := napi.CreateString(env, str)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got float64
+		if err := napi.ValueFromCoerce(strValue, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got != number && !(math.IsNaN(got) && math.IsNaN(number)) {
+			t.Errorf("Number(String(%v)) = %v, string %q", number, got, str)
+		}
+
+		var boolean bool
+		if err := napi.ValueFromCoerce(strValue, &boolean); err != nil {
+			t.Fatal(err)
+		} else if !boolean {
+			t.Errorf("Boolean(%q) = false", str)
+		}
+	})
+}
+
+// Decimal string of integer coerce to same integer, any string coerce to same string
+func FuzzCoerceString(f *testing.F) {
+	f.Add("42")
+	f.Add("")
+	f.Add(" -7 ")
+	f.Add("gopher")
+	f.Fuzz(func(t *testing.T, str string) {
+		env := newEnv(t)
+		value, err := napi.CreateString(env, str)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got string
+		if err := napi.ValueFromCoerce(value, &got); err != nil {
+			t.Fatal(err)
+		} else if got != str {
+			t.Errorf("String(%q) = %q", str, got)
+		}
+
+		var boolean bool
+		if err := napi.ValueFromCoerce(value, &boolean); err != nil {
+			t.Fatal(err)
+		} else if boolean != (str != "") {
+			t.Errorf("Boolean(%q) = %v", str, boolean)
+		}
+
+		if integer, err := strconv.ParseInt(str, 10, 32); err == nil {
+			var number int32
+			if err := napi.ValueFromCoerce(value, &number); err != nil {
+				t.Fatal(err)
+			} else if number != int32(integer) {
+				t.Errorf("Number(%q) = %d, want %d", str, number, integer)
+			}
+		}
+	})
+}
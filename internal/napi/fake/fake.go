@@ -0,0 +1,398 @@
+// Package fake implement [napi.Backend] in memory, without Node.js, to test napi-go with go test.
+//
+// Fake model Javascript values (undefined, null, boolean, number, bigint, string, symbol, object, array, function,
+// error, date, buffer, arraybuffer, typed array, dataview, promise and external) and exceptions,
+// RunScript, async work and threadsafe functions are not supported and return napi_generic_failure,
+// message of error say call is not supported by fake. Without threadsafe functions RunOnJS, Post, Deferred
+// and Go functions decoded from Javascript functions of napi-go return error.
+//
+//	func TestMain(m *testing.M) {
+//		fake.Install()
+//		os.Exit(m.Run())
+//	}
+//
+//	func TestValueOf(t *testing.T) {
+//		env := napi.N_APIEnv(fake.NewEnv())
+//		value, err := napi.ValueOf(env, map[string]int{"a": 1})
+//		...
+//	}
+package fake
+
+import (
+	"math/big"
+	"sync"
+	"unsafe"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// Node-API and Node.js version reported by fake env
+const (
+	Version     = 10
+	NodeVersion = "v22.20.0"
+)
+
+// Backend implement [napi.Backend] with fake envs created by [NewEnv]
+type Backend struct {
+	napi.UnimplementedBackend
+}
+
+var _ napi.Backend = Backend{}
+
+var installOnce sync.Once
+
+// Install set fake [Backend] as backend of Node-API calls,
+// after Install only envs created by [NewEnv] can be used.
+func Install() {
+	installOnce.Do(func() { napi.SetBackend(Backend{}) })
+}
+
+// Env is fake napi_env, Javascript values of env are only valid in same env.
+type Env struct {
+	undefined, null, trueValue, falseValue *Value
+	global                                 *Value
+
+	objectProto, functionProto, arrayProto, errorProto, dateProto, promiseProto, bufferProto *Value
+	typeErrorProto, rangeErrorProto, syntaxErrorProto                                        *Value
+	typedArrayProto, arrayBufferProto, dataViewProto                                         *Value
+
+	exception  *Value            // Pending exception
+	lastStatus napi.Status       // Status of last call
+	message    string            // Message of last error, empty to message of status
+	data       any               // Instance data
+	library    sync.Map          // napi-go library data
+	symbols    map[string]*Value // Symbol.for registry
+	adjusted   int64             // AdjustExternalMemory
+}
+
+// NewEnv create new fake env with global object, call [Install] before to use env with napi-go.
+func NewEnv() napi.Env {
+	Install()
+	e := &Env{symbols: map[string]*Value{}}
+	e.undefined = &Value{kind: napi.ValueTypeUndefined}
+	e.null = &Value{kind: napi.ValueTypeNull}
+	e.trueValue = &Value{kind: napi.ValueTypeBoolean, boolean: true}
+	e.falseValue = &Value{kind: napi.ValueTypeBoolean}
+	e.setupGlobal()
+	env := napi.Env(unsafe.Pointer(e))
+	napi.InitializeInstanceData(env)
+	return env
+}
+
+// Class of object, internal slots of Javascript objects
+type class int
+
+const (
+	classObject class = iota
+	classArray
+	classFunction
+	classError
+	classDate
+	classPromise
+	classArrayBuffer
+	classTypedArray
+	classBuffer
+	classDataView
+	classExternal
+	classWrapper // Object(primitive)
+)
+
+// Value is fake napi_value
+type Value struct {
+	kind    napi.ValueType
+	boolean bool
+	number  float64
+	str     string
+	bigint  *big.Int
+	*object // object, function and external
+}
+
+// Promise state
+type PromiseState int
+
+const (
+	Pending PromiseState = iota
+	Fulfilled
+	Rejected
+)
+
+func (state PromiseState) String() string {
+	switch state {
+	case Fulfilled:
+		return "fulfilled"
+	case Rejected:
+		return "rejected"
+	default:
+		return "pending"
+	}
+}
+
+type object struct {
+	class      class
+	proto      *Value
+	keys       []key
+	props      map[key]*property
+	extensible bool
+
+	length    int                 // Array length
+	callback  napi.Callback       // Function
+	date      float64             // Date time value
+	data      *[]byte             // ArrayBuffer memory, shared with views
+	detached  bool                // ArrayBuffer detached
+	buffer    *Value              // ArrayBuffer of view
+	offset    int                 // Byte offset of view
+	size      int                 // Length of view, elements to typed array and bytes to dataview
+	arrayType napi.TypedArrayType // TypedArray type
+	state     PromiseState        // Promise state
+	result    *Value              // Promise result
+	external  unsafe.Pointer      // External value
+	wrapped   unsafe.Pointer      // napi_wrap native object
+	isWrapped bool
+	primitive *Value // Primitive of Object(primitive)
+}
+
+// Property key, string or symbol
+type key struct {
+	name   string
+	symbol *Value
+}
+
+type property struct {
+	value                              *Value
+	getter, setter                     *Value
+	enumerable, writable, configurable bool
+}
+
+func envOf(env napi.Env) *Env {
+	return (*Env)(unsafe.Pointer(env))
+}
+
+func valueOf(value napi.Value) *Value {
+	return (*Value)(unsafe.Pointer(value))
+}
+
+func (v *Value) napiValue() napi.Value {
+	return napi.Value(unsafe.Pointer(v))
+}
+
+// PromiseResult return state and result of promise, result is nil if promise is pending.
+func PromiseResult(value napi.Value) (PromiseState, napi.Value) {
+	v := valueOf(value)
+	if v == nil || v.object == nil || v.class != classPromise || v.result == nil {
+		return Pending, nil
+	}
+	return v.state, v.result.napiValue()
+}
+
+// PendingException return pending exception of env without clear, nil if not exists.
+func PendingException(env napi.Env) napi.Value {
+	if e := envOf(env); e.exception != nil {
+		return e.exception.napiValue()
+	}
+	return nil
+}
+
+func (e *Env) set(status napi.Status) napi.Status {
+	e.lastStatus, e.message = status, ""
+	return status
+}
+
+// Fail call not supported by fake with napi_generic_failure and message
+func (e *Env) unsupported(call string) napi.Status {
+	e.lastStatus, e.message = napi.StatusGenericFailure, call+" is not supported by fake backend"
+	return e.lastStatus
+}
+
+func (e *Env) boolean(value bool) *Value {
+	if value {
+		return e.trueValue
+	}
+	return e.falseValue
+}
+
+func (e *Env) number(value float64) *Value {
+	return &Value{kind: napi.ValueTypeNumber, number: value}
+}
+
+func (e *Env) string(value string) *Value {
+	return &Value{kind: napi.ValueTypeString, str: value}
+}
+
+// Create object with class and prototype
+func (e *Env) newObject(class class, proto *Value) *Value {
+	return &Value{kind: napi.ValueTypeObject, object: &object{class: class, proto: proto, props: map[key]*property{}, extensible: true}}
+}
+
+// Create function with Go callback
+func (e *Env) newFunction(name string, callback napi.Callback) *Value {
+	fn := e.newObject(classFunction, e.functionProto)
+	fn.kind, fn.callback = napi.ValueTypeFunction, callback
+	fn.define(key{name: "name"}, &property{value: e.string(name), configurable: true})
+	fn.define(key{name: "length"}, &property{value: e.number(0), configurable: true})
+	if e.objectProto != nil {
+		proto := e.newObject(classObject, e.objectProto)
+		proto.define(key{name: "constructor"}, &property{value: fn, writable: true, configurable: true})
+		fn.define(key{name: "prototype"}, &property{value: proto, writable: true})
+	}
+	return fn
+}
+
+// Create error with prototype, message and code
+func (e *Env) newError(proto *Value, code, msg *Value) *Value {
+	err := e.newObject(classError, proto)
+	err.define(key{name: "message"}, &property{value: msg, writable: true, configurable: true})
+	name := e.getProperty(err, key{name: "name"})
+	err.define(key{name: "stack"}, &property{value: e.string(name.str + ": " + msg.str + "\n    at <fake>"), writable: true, configurable: true})
+	if code != nil {
+		err.define(key{name: "code"}, &property{value: code, enumerable: true, writable: true, configurable: true})
+	}
+	return err
+}
+
+// Throw error to env, used by fake functions
+func (e *Env) throw(proto *Value, msg string) napi.Status {
+	if e.exception == nil {
+		e.exception = e.newError(proto, nil, e.string(msg))
+	}
+	return e.set(napi.StatusPendingException)
+}
+
+// Create global object with Object, Function, Array, Error, TypeError, RangeError, SyntaxError, Date, Promise, ArrayBuffer and DataView constructors
+func (e *Env) setupGlobal() {
+	e.objectProto = e.newObject(classObject, nil)
+	e.functionProto = e.newObject(classObject, e.objectProto)
+	e.arrayProto = e.newObject(classObject, e.objectProto)
+	e.errorProto = e.newObject(classObject, e.objectProto)
+	e.typeErrorProto = e.newObject(classObject, e.errorProto)
+	e.rangeErrorProto = e.newObject(classObject, e.errorProto)
+	e.syntaxErrorProto = e.newObject(classObject, e.errorProto)
+	e.dateProto = e.newObject(classObject, e.objectProto)
+	e.promiseProto = e.newObject(classObject, e.objectProto)
+	e.arrayBufferProto = e.newObject(classObject, e.objectProto)
+	e.typedArrayProto = e.newObject(classObject, e.objectProto)
+	e.bufferProto = e.newObject(classObject, e.typedArrayProto)
+	e.dataViewProto = e.newObject(classObject, e.objectProto)
+	for proto, name := range map[*Value]string{e.errorProto: "Error", e.typeErrorProto: "TypeError", e.rangeErrorProto: "RangeError", e.syntaxErrorProto: "SyntaxError"} {
+		proto.define(key{name: "name"}, &property{value: e.string(name), writable: true, configurable: true})
+		proto.define(key{name: "message"}, &property{value: e.string(""), writable: true, configurable: true})
+	}
+
+	e.global = e.newObject(classObject, e.objectProto)
+	constructors := []struct {
+		name  string
+		proto *Value
+		new   func(args []*Value) *Value
+	}{
+		{"Object", e.objectProto, func([]*Value) *Value { return e.newObject(classObject, e.objectProto) }},
+		{"Function", e.functionProto, nil},
+		{"Array", e.arrayProto, func(args []*Value) *Value {
+			arr := e.newObject(classArray, e.arrayProto)
+			if len(args) == 1 && args[0].kind == napi.ValueTypeNumber {
+				arr.length = int(args[0].number)
+			}
+			return arr
+		}},
+		{"Error", e.errorProto, e.errorConstructor(e.errorProto)},
+		{"TypeError", e.typeErrorProto, e.errorConstructor(e.typeErrorProto)},
+		{"RangeError", e.rangeErrorProto, e.errorConstructor(e.rangeErrorProto)},
+		{"SyntaxError", e.syntaxErrorProto, e.errorConstructor(e.syntaxErrorProto)},
+		{"Date", e.dateProto, func(args []*Value) *Value {
+			date := e.newObject(classDate, e.dateProto)
+			if len(args) > 0 {
+				date.date = e.toNumber(args[0])
+			}
+			return date
+		}},
+		{"Promise", e.promiseProto, nil},
+		{"ArrayBuffer", e.arrayBufferProto, nil},
+		{"DataView", e.dataViewProto, nil},
+	}
+	for _, constructor := range constructors {
+		fn := e.newFunction(constructor.name, e.native(func(this *Value, args []*Value) (*Value, napi.Status) {
+			if constructor.new == nil {
+				return nil, e.throw(e.typeErrorProto, "fake: "+constructor.name+" constructor is not supported")
+			}
+			return constructor.new(args), napi.StatusOK
+		}))
+		fn.define(key{name: "prototype"}, &property{value: constructor.proto})
+		constructor.proto.define(key{name: "constructor"}, &property{value: fn, writable: true, configurable: true})
+		e.global.define(key{name: constructor.name}, &property{value: fn, writable: true, configurable: true})
+	}
+
+	object := e.getProperty(e.global, key{name: "Object"})
+	object.define(key{name: "defineProperty"}, &property{value: e.newFunction("defineProperty", e.native(e.defineProperty)), writable: true, configurable: true})
+	object.define(key{name: "keys"}, &property{value: e.newFunction("keys", e.native(func(_ *Value, args []*Value) (*Value, napi.Status) {
+		if len(args) == 0 || args[0].object == nil {
+			return nil, e.throw(e.typeErrorProto, "Cannot convert undefined or null to object")
+		}
+		return e.keysArray(args[0], true, true), napi.StatusOK
+	})), writable: true, configurable: true})
+	e.global.define(key{name: "globalThis"}, &property{value: e.global, writable: true, configurable: true})
+}
+
+func (e *Env) errorConstructor(proto *Value) func(args []*Value) *Value {
+	return func(args []*Value) *Value {
+		msg := e.string("")
+		if len(args) > 0 && args[0].kind != napi.ValueTypeUndefined {
+			msg = e.string(e.toString(args[0]))
+		}
+		return e.newError(proto, nil, msg)
+	}
+}
+
+// Create napi.Callback from Go function with this and arguments
+func (e *Env) native(fn func(this *Value, args []*Value) (*Value, napi.Status)) napi.Callback {
+	return func(env napi.Env, info napi.CallbackInfo) napi.Value {
+		call := (*callbackInfo)(unsafe.Pointer(info))
+		result, status := fn(call.this, call.args)
+		if status != napi.StatusOK || result == nil {
+			return nil
+		}
+		return result.napiValue()
+	}
+}
+
+// Object.defineProperty(obj, key, descriptor)
+func (e *Env) defineProperty(_ *Value, args []*Value) (*Value, napi.Status) {
+	for len(args) < 3 {
+		args = append(args, e.undefined)
+	}
+	obj, descriptor := args[0], args[2]
+	if obj.object == nil || descriptor.object == nil {
+		return nil, e.throw(e.typeErrorProto, "Object.defineProperty called on non-object")
+	}
+	k := e.toKey(args[1])
+
+	prop := &property{}
+	if current := obj.props[k]; current != nil {
+		if !current.configurable {
+			return nil, e.throw(e.typeErrorProto, "Cannot redefine property: "+k.name)
+		}
+		*prop = *current
+	} else if !obj.extensible {
+		return nil, e.throw(e.typeErrorProto, "Cannot define property "+k.name+", object is not extensible")
+	}
+
+	get, set := descriptor.props[key{name: "get"}], descriptor.props[key{name: "set"}]
+	if get != nil || set != nil {
+		prop.value, prop.writable = nil, false
+		if get != nil {
+			prop.getter = get.value
+		}
+		if set != nil {
+			prop.setter = set.value
+		}
+	} else if value := descriptor.props[key{name: "value"}]; value != nil {
+		prop.value, prop.getter, prop.setter = value.value, nil, nil
+	}
+	if prop.value == nil && prop.getter == nil && prop.setter == nil {
+		prop.value = e.undefined
+	}
+	for name, target := range map[string]*bool{"enumerable": &prop.enumerable, "writable": &prop.writable, "configurable": &prop.configurable} {
+		if flag := descriptor.props[key{name: name}]; flag != nil {
+			*target = e.toBool(flag.value)
+		}
+	}
+	obj.define(k, prop)
+	return obj, napi.StatusOK
+}
@@ -0,0 +1,76 @@
+package fake_test
+
+import (
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	internalNapi "sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi/fake"
+)
+
+// Create env of fake, fail test if exception is pending at end of test
+func newEnv(t testing.TB) napi.EnvType {
+	t.Helper()
+	env := fake.NewEnv()
+	t.Cleanup(func() {
+		if fake.PendingException(env) != nil {
+			t.Errorf("exception pending at end of test: %s", describe(t, thrown(t, napi.N_APIEnv(env))))
+		}
+	})
+	return napi.N_APIEnv(env)
+}
+
+// Return type of value, fail if value is nil
+func typeOf(t testing.TB, value napi.ValueType) napi.NapiType {
+	t.Helper()
+	if value == nil {
+		t.Fatal("value is nil")
+	}
+	typeOf, err := value.Type()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return typeOf
+}
+
+// Describe value with String()
+func describe(t testing.TB, value napi.ValueType) string {
+	t.Helper()
+	str, err := napi.CoerceString(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := str.Utf8Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+// Get and clear pending exception of env, fail if not exists
+func thrown(t testing.TB, env napi.EnvType) *napi.Object {
+	t.Helper()
+	exception, status := internalNapi.GetAndClearLastException(env.NapiValue())
+	if status != internalNapi.StatusOK || exception == nil {
+		t.Fatalf("exception not pending: %s", status)
+	}
+	value := napi.N_APIValue(env, exception)
+	if typeOf(t, value) != napi.TypeError {
+		t.Fatalf("exception is not error: %s", describe(t, value))
+	}
+	return napi.ToObject(value)
+}
+
+// Return property of object decoded to string
+func propertyString(t testing.TB, object *napi.Object, name string) string {
+	t.Helper()
+	value, err := object.Get(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var str string
+	if err := napi.ValueFrom(value, &str); err != nil {
+		t.Fatalf("%s: %s", name, err)
+	}
+	return str
+}
@@ -0,0 +1,201 @@
+package fake_test
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+)
+
+// Create Javascript function with GoFuncOf
+func goFunc(t testing.TB, env napi.EnvType, function any) *napi.Function {
+	t.Helper()
+	fn, err := napi.GoFuncOf(env, function)
+	if err != nil {
+		t.Fatal(err)
+	} else if typeOf(t, fn) != napi.TypeFunction {
+		t.Fatalf("GoFuncOf return %s", typeOf(t, fn))
+	}
+	return napi.ToFunction(fn)
+}
+
+// Convert Go values to Javascript arguments with ValueOf, nil is undefined
+func jsArgs(t testing.TB, env napi.EnvType, args ...any) []napi.ValueType {
+	t.Helper()
+	values := make([]napi.ValueType, len(args))
+	for index, arg := range args {
+		value, err := napi.ValueOf(env, arg)
+		if err != nil {
+			t.Fatal(err)
+		} else if value == nil {
+			if value, err = env.Undefined(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		values[index] = value
+	}
+	return values
+}
+
+func TestGoFuncOfArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		function any
+		args     []any
+		want     any
+	}{
+		{"required", func(a string, b int) string { return strings.Repeat(a, b) }, []any{"ab", 2}, "abab"},
+		{"extra args ignored", func(a int) int { return a * 2 }, []any{21, "extra", true}, 42},
+		{"missing string", func(a string) bool { return a == "" }, nil, true},
+		{"missing trailing int", func(a string, b int) string { return strings.Repeat(a, b+1) }, []any{"a"}, "a"},
+		{"missing struct", func(u user) bool { return u.Name == "" && u.Tags == nil }, nil, true},
+		{"missing pointer", func(a *int) bool { return a == nil }, nil, true},
+		{"undefined pointer", func(a *int) bool { return a == nil }, []any{nil}, true},
+		{"pointer", func(a *int) int { return *a }, []any{7}, 7},
+		{"missing interface", func(a napi.ValueType) bool { return a == nil }, nil, true},
+		{"missing optional", func(a string, b napi.Optional[string]) string { return b.Or("Hello") + ", " + a }, []any{"Ana"}, "Hello, Ana"},
+		{"optional", func(a string, b napi.Optional[string]) string { return b.Or("Hello") + ", " + a }, []any{"Ana", "Hi"}, "Hi, Ana"},
+		{"variadic", func(a string, b ...int) int { return len(a) + len(b) }, []any{"abc", 1, 2}, 5},
+		{"variadic empty", func(a string, b ...int) int { return len(a) + len(b) }, []any{"abc"}, 3},
+		{"struct", func(u user) string { return u.Name }, []any{map[string]any{"name": "Bia"}}, "Bia"},
+		{"callback info", func(ci *napi.CallbackInfo, a int) int { return len(ci.Args) + a }, []any{1, 2}, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := newEnv(t)
+			res, err := goFunc(t, env, test.function).Call(jsArgs(t, env, test.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := reflect.New(reflect.TypeOf(test.want))
+			if err := napi.ValueFrom(res, got.Interface()); err != nil {
+				t.Fatal(err)
+			} else if !reflect.DeepEqual(got.Elem().Interface(), test.want) {
+				t.Errorf("call with %#v = %#v, want %#v", test.args, got.Elem(), test.want)
+			}
+		})
+	}
+}
+
+func TestGoFuncOfArgsError(t *testing.T) {
+	tests := []struct {
+		name     string
+		function any
+		args     []any
+		code     string
+		message  string
+	}{
+		{"undefined string", func(a string) string { return a }, []any{nil}, "ERR_INVALID_ARG_TYPE", "The argument at index 0 must be of type string. Received type undefined"},
+		{"wrong type", func(a int) int { return a }, []any{"42"}, "ERR_INVALID_ARG_TYPE", "The argument at index 0 must be of type number or bigint. Received type string"},
+		{"wrong optional type", func(a napi.Optional[bool]) bool { return a.Value }, []any{1}, "ERR_INVALID_ARG_TYPE", "The argument at index 0 must be of type boolean. Received type number"},
+		{"wrong variadic type", func(a ...string) int { return len(a) }, []any{"a", 2}, "ERR_INVALID_ARG_TYPE", "The argument at index 1 must be of type string. Received type number"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := newEnv(t)
+			if _, err := goFunc(t, env, test.function).Call(jsArgs(t, env, test.args...)...); err == nil {
+				t.Fatal("call not return error")
+			}
+			exception := thrown(t, env)
+			if code := propertyString(t, exception, "code"); code != test.code {
+				t.Errorf("code = %q, want %q", code, test.code)
+			}
+			if message := propertyString(t, exception, "message"); message != test.message {
+				t.Errorf("message = %q, want %q", message, test.message)
+			}
+		})
+	}
+}
+
+func TestGoFuncOfThis(t *testing.T) {
+	env := newEnv(t)
+	this, err := napi.ValueOf(env, map[string]string{"name": "Ana"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn := goFunc(t, env, func(this napi.This, greeting string) (string, error) {
+		name, err := this.Get("name")
+		if err != nil {
+			return "", err
+		}
+		var str string
+		err = napi.ValueFrom(name, &str)
+		return greeting + ", " + str, err
+	})
+	res, err := fn.CallWithGlobal(this, jsArgs(t, env, "Hi")...)
+	if err != nil {
+		t.Fatal(err)
+	} else if got := describe(t, res); got != "Hi, Ana" {
+		t.Errorf("call = %q, want \"Hi, Ana\"", got)
+	}
+}
+
+func TestGoFuncOfLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		function any
+		want     int
+	}{
+		{"trailing int", func(a string, b int) {}, 0},
+		{"trailing optional", func(a string, b *int, c napi.Optional[string]) {}, 0},
+		{"variadic", func(a string, b ...int) {}, 0},
+		{"this skipped", func(this napi.This, a string) {}, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := newEnv(t)
+			length, err := napi.ToObject(goFunc(t, env, test.function)).Get("length")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got int
+			if err := napi.ValueFrom(length, &got); err != nil {
+				t.Fatal(err)
+			} else if got != test.want {
+				t.Errorf("length = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+// Arguments decoded by Go function are same of arguments of Javascript call
+func FuzzGoFuncOf(f *testing.F) {
+	f.Add("gopher", 1.5)
+	f.Add("", 0.0)
+	f.Add("日本語 🐹", math.Inf(1))
+	f.Fuzz(func(t *testing.T, str string, number float64) {
+		if !utf8.ValidString(str) {
+			t.Skip("Javascript strings replace invalid UTF-8")
+		}
+		env := newEnv(t)
+		fn := goFunc(t, env, func(str string, number float64, opt napi.Optional[string]) []any {
+			return []any{str, number, opt.Or(str)}
+		})
+		res, err := fn.Call(jsArgs(t, env, str, number)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got struct {
+			Str    string
+			Number float64
+			Opt    string
+		}
+		var list []napi.ValueType
+		if err := napi.ValueFrom(res, &list); err != nil {
+			t.Fatal(err)
+		} else if len(list) != 3 {
+			t.Fatalf("result length %d, want 3", len(list))
+		}
+		for index, ptr := range []any{&got.Str, &got.Number, &got.Opt} {
+			if err := napi.ValueFrom(list[index], ptr); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got.Str != str || got.Opt != str || (got.Number != number && !(math.IsNaN(got.Number) && math.IsNaN(number))) {
+			t.Errorf("call(%q, %v) = %#v", str, number, got)
+		}
+	})
+}
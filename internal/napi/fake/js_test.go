@@ -0,0 +1,204 @@
+package fake_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	internalNapi "sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+type user struct {
+	Name  string `napi:"name"`
+	Age   int    `napi:"age,omitempty"`
+	Admin bool   `napi:"admin"`
+	Tags  []string
+	skip  int
+}
+
+func TestValueOf(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  napi.NapiType
+	}{
+		{"string", "gopher", napi.TypeString},
+		{"int", 42, napi.TypeNumber},
+		{"float", 1.5, napi.TypeNumber},
+		{"int64", int64(1) << 40, napi.TypeBigInt},
+		{"bool", true, napi.TypeBoolean},
+		{"nil", nil, napi.TypeUndefined},
+		{"nil pointer", (*int)(nil), napi.TypeUndefined},
+		{"pointer", &[]int{1}, napi.TypeArray},
+		{"slice", []int{1, 2, 3}, napi.TypeArray},
+		{"array", [2]string{"a", "b"}, napi.TypeArray},
+		{"bytes", []byte{0xca, 0xfe}, napi.TypeTypedArray}, // Buffer is Uint8Array, checked by TestValueOfBytes
+		{"map", map[string]int{"a": 1}, napi.TypeObject},
+		{"struct", user{Name: "Ana"}, napi.TypeObject},
+		{"time", time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), napi.TypeDate},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := newEnv(t)
+			value, err := napi.ValueOf(env, test.value)
+			if err != nil {
+				t.Fatal(err)
+			} else if value == nil {
+				if value, err = env.Undefined(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got := typeOf(t, value); got != test.want {
+				t.Errorf("ValueOf(%#v) type = %s, want %s", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestValueOfBytes(t *testing.T) {
+	env := newEnv(t)
+	value, err := napi.ValueOf(env, []byte{0xca, 0xfe})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isBuffer, status := internalNapi.IsBuffer(env.NapiValue(), value.NapiValue()); status != internalNapi.StatusOK || !isBuffer {
+		t.Fatalf("ValueOf([]byte) is not Buffer: %s", status)
+	}
+	data, err := napi.ToBuffer(value).Data()
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(data, []byte{0xca, 0xfe}) {
+		t.Errorf("Buffer data = %x, want cafe", data)
+	}
+}
+
+func TestValueOfStruct(t *testing.T) {
+	env := newEnv(t)
+	value, err := napi.ValueOf(env, user{Name: "Ana", Tags: []string{"go"}, skip: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := napi.ToObject(value).GetPropertyNames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	if err := napi.ValueFrom(names, &keys); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"name", "age", "admin", "Tags"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("keys = %q, want %q", keys, want)
+	}
+}
+
+func TestValueRoundTrip(t *testing.T) {
+	tests := []any{
+		"gopher",
+		42,
+		-1.25,
+		int64(1) << 40,
+		uint64(math.MaxUint64),
+		true,
+		[]int{1, 2, 3},
+		[]byte{0xca, 0xfe},
+		map[string]int{"a": 1, "b": 2},
+		user{Name: "Ana", Age: 30, Admin: true, Tags: []string{"go"}},
+		&user{Name: "Bia", Tags: []string{}}, // nil slice is empty array
+		time.Date(2025, 1, 2, 3, 4, 5, 6e6, time.UTC),
+	}
+	for _, want := range tests {
+		t.Run(reflect.TypeOf(want).String(), func(t *testing.T) {
+			env := newEnv(t)
+			value, err := napi.ValueOf(env, want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := reflect.New(reflect.TypeOf(want))
+			if err := napi.ValueFrom(value, got.Interface()); err != nil {
+				t.Fatal(err)
+			}
+			if wantTime, ok := want.(time.Time); ok {
+				if !wantTime.Equal(got.Elem().Interface().(time.Time)) {
+					t.Errorf("ValueFrom = %v, want %v", got.Elem(), want)
+				}
+			} else if !reflect.DeepEqual(got.Elem().Interface(), want) {
+				t.Errorf("ValueFrom = %#v, want %#v", got.Elem(), want)
+			}
+		})
+	}
+}
+
+func TestValueFromError(t *testing.T) {
+	env := newEnv(t)
+	str, err := napi.CreateString(env, "gopher")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var number int
+	if err := napi.ValueFrom(str, &number); err == nil {
+		t.Error("decode string in int not return error")
+	}
+	var list []string
+	if err := napi.ValueFrom(str, &list); err == nil {
+		t.Error("decode string in slice not return error")
+	}
+	if err := napi.ValueFrom(str, number); err == nil {
+		t.Error("decode to not pointer not return error")
+	}
+}
+
+// Round trip of Go values with ValueOf and ValueFrom
+func FuzzValueRoundTrip(f *testing.F) {
+	f.Add("gopher", int64(42), 1.5, true, []byte{0xca, 0xfe})
+	f.Add("", int64(-1)<<60, math.Inf(-1), false, []byte{})
+	f.Add("日本語 🐹", int64(math.MaxInt64), -0.0, true, []byte(nil))
+	f.Fuzz(func(t *testing.T, str string, integer int64, float float64, boolean bool, data []byte) {
+		if !utf8.ValidString(str) {
+			t.Skip("Javascript strings replace invalid UTF-8")
+		}
+		type value struct {
+			Str     string
+			Integer int64
+			Int32   int32
+			Float   float64
+			Boolean bool
+			Data    []byte
+			List    []string
+			Map     map[string]float64
+		}
+		want := value{
+			Str:     str,
+			Integer: integer,
+			Int32:   int32(integer),
+			Float:   float,
+			Boolean: boolean,
+			Data:    data,
+			List:    []string{str, str},
+			Map:     map[string]float64{str: float},
+		}
+
+		env := newEnv(t)
+		jsValue, err := napi.ValueOf(env, want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got value
+		if err := napi.ValueFrom(jsValue, &got); err != nil {
+			t.Fatal(err)
+		}
+
+		if math.IsNaN(want.Float) && math.IsNaN(got.Float) && math.IsNaN(got.Map[str]) {
+			got.Float, got.Map[str] = want.Float, want.Float // NaN not equal NaN
+		}
+		if len(want.Data) == 0 && len(got.Data) == 0 {
+			got.Data = want.Data // Empty Buffer decode nil or empty slice
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ValueFrom(ValueOf(%#v)) = %#v", want, got)
+		}
+	})
+}
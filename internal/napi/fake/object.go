@@ -0,0 +1,328 @@
+package fake
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// Set own property, keep insertion order of keys
+func (v *Value) define(k key, prop *property) {
+	if v.class == classArray {
+		if index, ok := arrayIndex(k); ok && index >= v.length {
+			v.length = index + 1
+		}
+	}
+	if _, ok := v.props[k]; !ok {
+		v.keys = append(v.keys, k)
+	}
+	v.props[k] = prop
+}
+
+// Delete own property, return false if is not configurable
+func (v *Value) remove(k key) bool {
+	prop, ok := v.props[k]
+	if !ok {
+		return true
+	} else if !prop.configurable {
+		return false
+	}
+	delete(v.props, k)
+	v.keys = slices.DeleteFunc(v.keys, func(other key) bool { return other == k })
+	return true
+}
+
+// Own keys in Javascript order: array indexes, strings and symbols
+func (v *Value) ownKeys() []key {
+	var indexes, names, symbols []key
+	for _, k := range v.keys {
+		switch _, isIndex := arrayIndex(k); {
+		case k.symbol != nil:
+			symbols = append(symbols, k)
+		case isIndex:
+			indexes = append(indexes, k)
+		default:
+			names = append(names, k)
+		}
+	}
+	slices.SortFunc(indexes, func(a, b key) int {
+		x, _ := arrayIndex(a)
+		y, _ := arrayIndex(b)
+		return x - y
+	})
+	return slices.Concat(indexes, names, symbols)
+}
+
+// Find property in object and prototypes
+func (v *Value) lookup(k key) *property {
+	for obj := v; obj != nil && obj.object != nil; obj = obj.proto {
+		if prop, ok := obj.props[k]; ok {
+			return prop
+		}
+	}
+	return nil
+}
+
+// Get property value, call getter with value as this
+func (e *Env) getProperty(v *Value, k key) *Value {
+	if v.object == nil {
+		return e.undefined
+	} else if v.class == classArray && k.symbol == nil && k.name == "length" {
+		return e.number(float64(v.length))
+	} else if v.class == classWrapper && v.primitive.kind == napi.ValueTypeString && k.symbol == nil && k.name == "length" {
+		return e.number(float64(len(utf16Of(v.primitive.str))))
+	}
+
+	prop := v.lookup(k)
+	switch {
+	case prop == nil:
+		return e.undefined
+	case prop.getter != nil:
+		result, status := e.call(v, prop.getter, nil, nil)
+		if status != napi.StatusOK {
+			return e.undefined
+		}
+		return result
+	case prop.value == nil:
+		return e.undefined
+	}
+	return prop.value
+}
+
+// Set property, call setter with value as this
+func (e *Env) setProperty(v *Value, k key, value *Value) napi.Status {
+	if v.object == nil {
+		return napi.StatusObjectExpected
+	} else if v.class == classArray && k.symbol == nil && k.name == "length" {
+		length := int(e.toNumber(value))
+		for _, old := range v.keys {
+			if index, ok := arrayIndex(old); ok && index >= length {
+				v.remove(old)
+			}
+		}
+		v.length = length
+		return napi.StatusOK
+	}
+
+	if prop := v.lookup(k); prop != nil {
+		switch {
+		case prop.setter != nil:
+			_, status := e.call(v, prop.setter, []*Value{value}, nil)
+			return status
+		case prop.getter != nil, !prop.writable:
+			return napi.StatusOK // Ignored in sloppy mode
+		}
+		if own, ok := v.props[k]; ok {
+			own.value = value
+			return napi.StatusOK
+		}
+	}
+	if !v.extensible {
+		return napi.StatusOK
+	}
+	v.define(k, &property{value: value, enumerable: true, writable: true, configurable: true})
+	return napi.StatusOK
+}
+
+// Array with own enumerable string keys, same of Object.keys
+func (e *Env) keysArray(v *Value, enumerable, numbersToStrings bool) *Value {
+	arr := e.newObject(classArray, e.arrayProto)
+	for _, k := range v.ownKeys() {
+		if k.symbol != nil || (enumerable && !v.props[k].enumerable) {
+			continue
+		}
+		name := e.string(k.name)
+		if index, ok := arrayIndex(k); ok && !numbersToStrings {
+			name = e.number(float64(index))
+		}
+		arr.define(key{name: strconv.Itoa(arr.length)}, &property{value: name, enumerable: true, writable: true, configurable: true})
+	}
+	return arr
+}
+
+// Convert value to property key, same of ToPropertyKey
+func (e *Env) toKey(v *Value) key {
+	if v.kind == napi.ValueTypeSymbol {
+		return key{symbol: v}
+	}
+	return key{name: e.toString(v)}
+}
+
+func arrayIndex(k key) (int, bool) {
+	if k.symbol != nil || k.name == "" || (len(k.name) > 1 && k.name[0] == '0') {
+		return 0, false
+	}
+	index, err := strconv.ParseUint(k.name, 10, 32)
+	if err != nil || index == math.MaxUint32 {
+		return 0, false
+	}
+	return int(index), true
+}
+
+// ToBoolean
+func (e *Env) toBool(v *Value) bool {
+	switch v.kind {
+	case napi.ValueTypeUndefined, napi.ValueTypeNull:
+		return false
+	case napi.ValueTypeBoolean:
+		return v.boolean
+	case napi.ValueTypeNumber:
+		return v.number != 0 && !math.IsNaN(v.number)
+	case napi.ValueTypeString:
+		return v.str != ""
+	case napi.ValueTypeBigint:
+		return v.bigint.Sign() != 0
+	}
+	return true
+}
+
+// ToNumber, symbol and bigint return NaN, check before to throw TypeError
+func (e *Env) toNumber(v *Value) float64 {
+	switch v.kind {
+	case napi.ValueTypeNull:
+		return 0
+	case napi.ValueTypeBoolean:
+		if v.boolean {
+			return 1
+		}
+		return 0
+	case napi.ValueTypeNumber:
+		return v.number
+	case napi.ValueTypeString:
+		str := strings.TrimSpace(v.str)
+		switch {
+		case str == "":
+			return 0
+		case str == "Infinity" || str == "+Infinity":
+			return math.Inf(1)
+		case str == "-Infinity":
+			return math.Inf(-1)
+		case strings.HasPrefix(str, "0x") || strings.HasPrefix(str, "0X"):
+			if number, err := strconv.ParseUint(str[2:], 16, 64); err == nil {
+				return float64(number)
+			}
+			return math.NaN()
+		case strings.ContainsAny(str, "_xXpPnN") || strings.HasPrefix(strings.TrimLeft(str, "+-"), "Inf"):
+			return math.NaN()
+		}
+		if number, err := strconv.ParseFloat(str, 64); err == nil {
+			return number
+		}
+	case napi.ValueTypeObject, napi.ValueTypeFunction:
+		switch v.class {
+		case classDate:
+			return v.date
+		case classWrapper:
+			return e.toNumber(v.primitive)
+		case classArray:
+			return e.toNumber(e.string(e.toString(v)))
+		}
+	}
+	return math.NaN()
+}
+
+// ToString, symbol return description, check before to throw TypeError
+func (e *Env) toString(v *Value) string {
+	switch v.kind {
+	case napi.ValueTypeUndefined:
+		return "undefined"
+	case napi.ValueTypeNull:
+		return "null"
+	case napi.ValueTypeBoolean:
+		return strconv.FormatBool(v.boolean)
+	case napi.ValueTypeNumber:
+		return numberString(v.number)
+	case napi.ValueTypeString:
+		return v.str
+	case napi.ValueTypeSymbol:
+		return "Symbol(" + v.str + ")"
+	case napi.ValueTypeBigint:
+		return v.bigint.String()
+	case napi.ValueTypeFunction:
+		return "function " + e.getProperty(v, key{name: "name"}).str + "() { [native code] }"
+	case napi.ValueTypeExternal:
+		return "[object Object]"
+	}
+
+	switch v.class {
+	case classArray:
+		values := make([]string, v.length)
+		for index := range values {
+			if item := e.getProperty(v, key{name: strconv.Itoa(index)}); item.kind != napi.ValueTypeUndefined && item.kind != napi.ValueTypeNull {
+				values[index] = e.toString(item)
+			}
+		}
+		return strings.Join(values, ",")
+	case classError:
+		name, msg := e.toString(e.getProperty(v, key{name: "name"})), e.toString(e.getProperty(v, key{name: "message"}))
+		if msg == "" {
+			return name
+		}
+		return name + ": " + msg
+	case classDate:
+		if math.IsNaN(v.date) {
+			return "Invalid Date"
+		}
+		return dateOf(v.date).Format("Mon Jan 02 2006 15:04:05 GMT-0700")
+	case classWrapper:
+		return e.toString(v.primitive)
+	}
+	return "[object Object]"
+}
+
+var exponent = regexp.MustCompile(`e([+-])0*(\d)`)
+
+// Number to string same of Javascript
+func numberString(number float64) string {
+	switch {
+	case math.IsNaN(number):
+		return "NaN"
+	case math.IsInf(number, 1):
+		return "Infinity"
+	case math.IsInf(number, -1):
+		return "-Infinity"
+	case number == 0:
+		return "0"
+	}
+	if abs := math.Abs(number); abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	return exponent.ReplaceAllString(strconv.FormatFloat(number, 'g', -1, 64), "e$1$2")
+}
+
+func utf16Of(str string) []uint16 {
+	var result []uint16
+	for _, r := range str {
+		if r >= 0x10000 {
+			r -= 0x10000
+			result = append(result, uint16(0xD800+(r>>10)), uint16(0xDC00+(r&0x3FF)))
+			continue
+		}
+		result = append(result, uint16(r))
+	}
+	return result
+}
+
+// Strict equality (===)
+func strictEquals(a, b *Value) bool {
+	if a.kind != b.kind {
+		return false
+	}
+	switch a.kind {
+	case napi.ValueTypeUndefined, napi.ValueTypeNull:
+		return true
+	case napi.ValueTypeBoolean:
+		return a.boolean == b.boolean
+	case napi.ValueTypeNumber:
+		return a.number == b.number
+	case napi.ValueTypeString:
+		return a.str == b.str
+	case napi.ValueTypeBigint:
+		return a.bigint.Cmp(b.bigint) == 0
+	}
+	return a == b || (a.object != nil && a.object == b.object)
+}
@@ -0,0 +1,58 @@
+package fake_test
+
+import (
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+)
+
+func TestOverload(t *testing.T) {
+	env := newEnv(t)
+	fn, err := napi.Overload(env, "describe",
+		func(name string) string { return "string " + name },
+		func(ci *napi.CallbackInfo) (napi.ValueType, error) { // Not napi.Callback type, accept any arguments
+			return napi.CreateString(ci.Env, "any")
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		args []any
+		want string
+	}{
+		{[]any{"Ana"}, "string Ana"},
+		{[]any{1, 2}, "any"},
+		{nil, "any"},
+	}
+	for _, test := range tests {
+		res, err := fn.Call(jsArgs(t, env, test.args...)...)
+		if err != nil {
+			t.Fatal(err)
+		} else if got := describe(t, res); got != test.want {
+			t.Errorf("call with %#v = %q, want %q", test.args, got, test.want)
+		}
+	}
+}
+
+func TestOverloadError(t *testing.T) {
+	env := newEnv(t)
+	fn, err := napi.Overload(env, "add",
+		func(a, b int) int { return a + b },
+		func(a, b string) string { return a + b },
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fn.Call(jsArgs(t, env, 1, "b")...); err == nil {
+		t.Fatal("call not return error")
+	}
+	exception := thrown(t, env)
+	if code := propertyString(t, exception, "code"); code != "ERR_INVALID_ARG_TYPE" {
+		t.Errorf("code = %q, want ERR_INVALID_ARG_TYPE", code)
+	}
+	want := "The arguments (number, string) do not match any signature of add: (number | bigint, number | bigint), (string, string)"
+	if message := propertyString(t, exception, "message"); message != want {
+		t.Errorf("message = %q, want %q", message, want)
+	}
+}
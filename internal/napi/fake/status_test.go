@@ -0,0 +1,43 @@
+package fake_test
+
+import (
+	"errors"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+)
+
+func TestStatusError(t *testing.T) {
+	env := newEnv(t)
+	number, err := napi.CreateNumber(env, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = napi.ToString(number).Utf8Value()
+	var statusErr *napi.StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("Utf8Value of number return %T, want *napi.StatusError", err)
+	}
+	if statusErr.Op != "napi_get_value_string_utf8" {
+		t.Errorf("Op = %q, want \"napi_get_value_string_utf8\"", statusErr.Op)
+	}
+	if statusErr.Status != napi.StatusStringExpected {
+		t.Errorf("Status = %s, want %s", statusErr.Status, napi.StatusStringExpected)
+	}
+	if statusErr.Message != "A string was expected" {
+		t.Errorf("Message = %q, want extended error info", statusErr.Message)
+	}
+	if want := "napi_get_value_string_utf8: " + napi.StatusStringExpected.String() + ": A string was expected"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	if !errors.Is(err, napi.ErrStringExpected) {
+		t.Error("errors.Is(err, ErrStringExpected) = false")
+	} else if errors.Is(err, napi.ErrObjectExpected) {
+		t.Error("errors.Is(err, ErrObjectExpected) = true")
+	}
+	if unwrap := statusErr.Unwrap(); unwrap != napi.ErrStringExpected {
+		t.Errorf("Unwrap() = %v, want ErrStringExpected", unwrap)
+	}
+}
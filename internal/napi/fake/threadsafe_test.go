@@ -0,0 +1,25 @@
+package fake_test
+
+import (
+	"strings"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+)
+
+// Threadsafe functions require event loop of Node.js, fake return error
+func TestThreadsafeUnsupported(t *testing.T) {
+	env := newEnv(t)
+	if _, err := napi.NewDeferred[string](env); err == nil || !strings.Contains(err.Error(), "not supported by fake backend") {
+		t.Errorf("NewDeferred error = %v", err)
+	}
+	if _, err := napi.CreateThreadsafeFunction(env, nil, nil, func(napi.EnvType, *napi.Function, any) {}, "napi-go/test", 0, 1, nil); err == nil || !strings.Contains(err.Error(), "not supported by fake backend") {
+		t.Errorf("CreateThreadsafeFunction error = %v", err)
+	}
+	if err := napi.Post(env, func(napi.EnvType) { t.Error("Post called function") }); err == nil {
+		t.Error("Post not return error")
+	}
+	if _, err := napi.RunOnJS(env, func(napi.EnvType) (int, error) { return 1, nil }); err == nil {
+		t.Error("RunOnJS not return error")
+	}
+}
@@ -1,6 +1,7 @@
 package napi
 
 /*
+#include <stdint.h>
 #include <stdlib.h>
 #include <node/node_api.h>
 
@@ -31,6 +32,11 @@ extern void ExecuteAsyncCompleteCallback(
 	napi_status status,
 	void *data
 );
+
+// Instance data is cgo.Handle, passed to Node-API as pointer
+static inline napi_status setInstanceData(napi_env env, uintptr_t data) {
+	return napi_set_instance_data(env, (void*)data, DeleteInstanceData, NULL);
+}
 */
 import "C"
 
@@ -43,6 +49,7 @@ import (
 
 type NapiGoInstanceData struct {
 	UserData      any
+	LibraryData   sync.Map // Values stored by napi-go per env
 	CallbackData  NapiGoInstanceCallbackData
 	AsyncWorkData NapiGoInstanceAsyncWorkData
 }
@@ -62,8 +69,6 @@ type NapiGoCallbackMapEntry struct {
 	ID       NapiGoCallbackID
 }
 
-type NapiGoAsyncWorkID int
-
 type NapiGoInstanceAsyncWorkData struct {
 	AsyncWorkMap NapiGoInstanceAsyncWorkMap
 	NextID       NapiGoAsyncWorkID
@@ -81,6 +86,7 @@ type NapiGoAsyncWorkMapEntry struct {
 type InstanceDataProvider interface {
 	GetUserData() any
 	SetUserData(userData any)
+	GetLibraryData() *sync.Map
 
 	GetCallbackData() CallbackDataProvider
 	GetAsyncWorkData() AsyncWorkDataProvider
@@ -104,7 +110,7 @@ var _ AsyncWorkDataProvider = &NapiGoInstanceAsyncWorkData{}
 
 const maxStackTraceSize = 8192
 
-func InitializeInstanceData(env Env) Status {
+func (cgoBackend) InitializeInstanceData(env Env) Status {
 	return setInstanceData(env, &NapiGoInstanceData{})
 }
 
@@ -243,12 +249,7 @@ func setInstanceData(env Env, data *NapiGoInstanceData) Status {
 	}
 
 	dataHandle := cgo.NewHandle(data)
-	return Status(C.napi_set_instance_data(
-		C.napi_env(env),
-		unsafe.Pointer(dataHandle),
-		C.napi_finalize(C.DeleteInstanceData),
-		nil,
-	))
+	return Status(C.setInstanceData(C.napi_env(env), C.uintptr_t(dataHandle)))
 }
 
 func (d *NapiGoInstanceData) GetUserData() any {
@@ -259,6 +260,10 @@ func (d *NapiGoInstanceData) SetUserData(userData any) {
 	d.UserData = userData
 }
 
+func (d *NapiGoInstanceData) GetLibraryData() *sync.Map {
+	return &d.LibraryData
+}
+
 func (d *NapiGoInstanceData) GetCallbackData() CallbackDataProvider {
 	return &d.CallbackData
 }
@@ -4,12 +4,12 @@ package napi
 // #include <node/node_api.h>
 import "C"
 
-import "unsafe"
+import (
+	"sync"
+	"unsafe"
+)
 
-type CallbackInfo unsafe.Pointer
-type Callback func(env Env, info CallbackInfo) Value
-
-func GetUndefined(env Env) (Value, Status) {
+func (cgoBackend) GetUndefined(env Env) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_undefined(
 		C.napi_env(env),
@@ -18,7 +18,7 @@ func GetUndefined(env Env) (Value, Status) {
 	return result, status
 }
 
-func GetNull(env Env) (Value, Status) {
+func (cgoBackend) GetNull(env Env) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_null(
 		C.napi_env(env),
@@ -27,7 +27,7 @@ func GetNull(env Env) (Value, Status) {
 	return result, status
 }
 
-func GetGlobal(env Env) (Value, Status) {
+func (cgoBackend) GetGlobal(env Env) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_global(
 		C.napi_env(env),
@@ -36,7 +36,7 @@ func GetGlobal(env Env) (Value, Status) {
 	return result, status
 }
 
-func GetBoolean(env Env, value bool) (Value, Status) {
+func (cgoBackend) GetBoolean(env Env, value bool) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_boolean(
 		C.napi_env(env),
@@ -46,7 +46,7 @@ func GetBoolean(env Env, value bool) (Value, Status) {
 	return result, status
 }
 
-func CreateObject(env Env) (Value, Status) {
+func (cgoBackend) CreateObject(env Env) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_object(
 		C.napi_env(env),
@@ -55,7 +55,7 @@ func CreateObject(env Env) (Value, Status) {
 	return result, status
 }
 
-func CreateArray(env Env) (Value, Status) {
+func (cgoBackend) CreateArray(env Env) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_array(
 		C.napi_env(env),
@@ -64,7 +64,7 @@ func CreateArray(env Env) (Value, Status) {
 	return result, status
 }
 
-func CreateArrayWithLength(env Env, length int) (Value, Status) {
+func (cgoBackend) CreateArrayWithLength(env Env, length int) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_array_with_length(
 		C.napi_env(env),
@@ -74,7 +74,7 @@ func CreateArrayWithLength(env Env, length int) (Value, Status) {
 	return result, status
 }
 
-func CreateDouble(env Env, value float64) (Value, Status) {
+func (cgoBackend) CreateDouble(env Env, value float64) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_double(
 		C.napi_env(env),
@@ -84,7 +84,7 @@ func CreateDouble(env Env, value float64) (Value, Status) {
 	return result, status
 }
 
-func CreateStringUtf8(env Env, str string) (Value, Status) {
+func (cgoBackend) CreateStringUtf8(env Env, str string) (Value, Status) {
 	cstr := C.CString(str)
 	defer C.free(unsafe.Pointer(cstr))
 
@@ -98,7 +98,7 @@ func CreateStringUtf8(env Env, str string) (Value, Status) {
 	return result, status
 }
 
-func CreateSymbol(env Env, description Value) (Value, Status) {
+func (cgoBackend) CreateSymbol(env Env, description Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_symbol(
 		C.napi_env(env),
@@ -108,7 +108,7 @@ func CreateSymbol(env Env, description Value) (Value, Status) {
 	return result, status
 }
 
-func CreateFunction(env Env, name string, cb Callback) (Value, Status) {
+func (cgoBackend) CreateFunction(env Env, name string, cb Callback) (Value, Status) {
 	provider, status := getInstanceData(env)
 	if status != StatusOK || provider == nil {
 		return nil, status
@@ -117,7 +117,7 @@ func CreateFunction(env Env, name string, cb Callback) (Value, Status) {
 	return provider.GetCallbackData().CreateCallback(env, name, cb)
 }
 
-func CreateError(env Env, code, msg Value) (Value, Status) {
+func (cgoBackend) CreateError(env Env, code, msg Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_error(
 		C.napi_env(env),
@@ -128,7 +128,7 @@ func CreateError(env Env, code, msg Value) (Value, Status) {
 	return result, status
 }
 
-func Typeof(env Env, value Value) (ValueType, Status) {
+func (cgoBackend) Typeof(env Env, value Value) (ValueType, Status) {
 	var result ValueType
 	status := Status(C.napi_typeof(
 		C.napi_env(env),
@@ -138,7 +138,7 @@ func Typeof(env Env, value Value) (ValueType, Status) {
 	return result, status
 }
 
-func GetValueDouble(env Env, value Value) (float64, Status) {
+func (cgoBackend) GetValueDouble(env Env, value Value) (float64, Status) {
 	var result float64
 	status := Status(C.napi_get_value_double(
 		C.napi_env(env),
@@ -148,7 +148,7 @@ func GetValueDouble(env Env, value Value) (float64, Status) {
 	return result, status
 }
 
-func GetValueBool(env Env, value Value) (bool, Status) {
+func (cgoBackend) GetValueBool(env Env, value Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_get_value_bool(
 		C.napi_env(env),
@@ -158,7 +158,7 @@ func GetValueBool(env Env, value Value) (bool, Status) {
 	return result, status
 }
 
-func GetValueStringUtf8(env Env, value Value) (string, Status) {
+func (cgoBackend) GetValueStringUtf8(env Env, value Value) (string, Status) {
 	// call napi_get_value_string_utf8 twice
 	// first is to get number of bytes
 	// second is to populate the actual string buffer
@@ -200,7 +200,7 @@ func GetValueStringUtf8(env Env, value Value) (string, Status) {
 	), status
 }
 
-func GetValueStringUtf16(env Env, value Value) ([]uint16, Status) {
+func (cgoBackend) GetValueStringUtf16(env Env, value Value) ([]uint16, Status) {
 	bufsize := C.size_t(0)
 	var strsize C.size_t
 
@@ -221,7 +221,7 @@ func GetValueStringUtf16(env Env, value Value) ([]uint16, Status) {
 	return unsafe.Slice((*uint16)(cstr), strsize), status
 }
 
-func SetProperty(env Env, object, key, value Value) Status {
+func (cgoBackend) SetProperty(env Env, object, key, value Value) Status {
 	return Status(C.napi_set_property(
 		C.napi_env(env),
 		C.napi_value(object),
@@ -230,7 +230,7 @@ func SetProperty(env Env, object, key, value Value) Status {
 	))
 }
 
-func SetElement(env Env, object Value, index int, value Value) Status {
+func (cgoBackend) SetElement(env Env, object Value, index int, value Value) Status {
 	return Status(C.napi_set_element(
 		C.napi_env(env),
 		C.napi_value(object),
@@ -239,7 +239,7 @@ func SetElement(env Env, object Value, index int, value Value) Status {
 	))
 }
 
-func StrictEquals(env Env, lhs, rhs Value) (bool, Status) {
+func (cgoBackend) StrictEquals(env Env, lhs, rhs Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_strict_equals(
 		C.napi_env(env),
@@ -250,12 +250,23 @@ func StrictEquals(env Env, lhs, rhs Value) (bool, Status) {
 	return result, status
 }
 
-type GetCbInfoResult struct {
-	Args []Value
-	This Value
+// GetExtendedErrorInfo Function to retrieve extended error information
+func (cgoBackend) GetExtendedErrorInfo(env Env) (*ExtendedError, Status) {
+	var errorInfo *C.napi_extended_error_info
+	status := Status(C.napi_get_last_error_info(C.napi_env(env), &errorInfo))
+	var extendErr *ExtendedError
+	if status == StatusOK {
+		extendErr = &ExtendedError{
+			Message:         C.GoString(errorInfo.error_message),
+			StatusCode:      Status(errorInfo.error_code),
+			EngineErrorCode: int32(errorInfo.engine_error_code),
+			EngineReserved:  errorInfo.engine_reserved,
+		}
+	}
+	return extendErr, status
 }
 
-func GetCbInfo(env Env, info CallbackInfo) (GetCbInfoResult, Status) {
+func (cgoBackend) GetCbInfo(env Env, info CallbackInfo) (GetCbInfoResult, Status) {
 	// call napi_get_cb_info twice
 	// first is to get total number of arguments
 	// second is to populate the actual arguments
@@ -296,14 +307,14 @@ func GetCbInfo(env Env, info CallbackInfo) (GetCbInfoResult, Status) {
 	}, status
 }
 
-func Throw(env Env, err Value) Status {
+func (cgoBackend) Throw(env Env, err Value) Status {
 	return Status(C.napi_throw(
 		C.napi_env(env),
 		C.napi_value(err),
 	))
 }
 
-func ThrowError(env Env, code, msg string) Status {
+func (cgoBackend) ThrowError(env Env, code, msg string) Status {
 	codeCStr, msgCCstr := C.CString(code), C.CString(msg)
 	defer C.free(unsafe.Pointer(codeCStr))
 	defer C.free(unsafe.Pointer(msgCCstr))
@@ -315,7 +326,7 @@ func ThrowError(env Env, code, msg string) Status {
 	))
 }
 
-func SetInstanceData(env Env, data any) Status {
+func (cgoBackend) SetInstanceData(env Env, data any) Status {
 	provider, status := getInstanceData(env)
 	if status != StatusOK || provider == nil {
 		return status
@@ -325,7 +336,7 @@ func SetInstanceData(env Env, data any) Status {
 	return status
 }
 
-func GetInstanceData(env Env) (any, Status) {
+func (cgoBackend) GetInstanceData(env Env) (any, Status) {
 	provider, status := getInstanceData(env)
 	if status != StatusOK || provider == nil {
 		return nil, status
@@ -334,20 +345,31 @@ func GetInstanceData(env Env) (any, Status) {
 	return provider.GetUserData(), status
 }
 
-func CreateExternal(env Env, data unsafe.Pointer, finalize Finalize, finalizeHint unsafe.Pointer) (Value, Status) {
+// Return [sync.Map] to store napi-go values per env
+func (cgoBackend) GetLibraryData(env Env) (*sync.Map, Status) {
+	provider, status := getInstanceData(env)
+	if status != StatusOK || provider == nil {
+		return nil, status
+	}
+
+	return provider.GetLibraryData(), status
+}
+
+func (cgoBackend) CreateExternal(env Env, data unsafe.Pointer, finalize Finalize, finalizeHint unsafe.Pointer) (Value, Status) {
 	var result Value
-	finalizer := FinalizeToFinalizer(finalize)
+	cFinalize, cHint := finalizeOf(finalize, finalizeHint)
 	status := Status(C.napi_create_external(
 		C.napi_env(env),
 		data,
-		C.napi_finalize(unsafe.Pointer(&finalizer)),
-		finalizeHint,
+		cFinalize,
+		cHint,
 		(*C.napi_value)(unsafe.Pointer(&result)),
 	))
+	deleteFinalize(cFinalize, cHint, status)
 	return result, status
 }
 
-func GetValueInt32(env Env, value Value) (int32, Status) {
+func (cgoBackend) GetValueInt32(env Env, value Value) (int32, Status) {
 	var result C.int32_t
 	status := Status(C.napi_get_value_int32(
 		C.napi_env(env),
@@ -357,7 +379,7 @@ func GetValueInt32(env Env, value Value) (int32, Status) {
 	return int32(result), status
 }
 
-func GetValueUint32(env Env, value Value) (uint32, Status) {
+func (cgoBackend) GetValueUint32(env Env, value Value) (uint32, Status) {
 	var result uint32
 	status := Status(C.napi_get_value_uint32(
 		C.napi_env(env),
@@ -367,7 +389,7 @@ func GetValueUint32(env Env, value Value) (uint32, Status) {
 	return result, status
 }
 
-func GetValueInt64(env Env, value Value) (int64, Status) {
+func (cgoBackend) GetValueInt64(env Env, value Value) (int64, Status) {
 	var result int64
 	status := Status(C.napi_get_value_int64(
 		C.napi_env(env),
@@ -377,7 +399,7 @@ func GetValueInt64(env Env, value Value) (int64, Status) {
 	return result, status
 }
 
-func GetValueBigIntInt64(env Env, value Value) (int64, bool, Status) {
+func (cgoBackend) GetValueBigIntInt64(env Env, value Value) (int64, bool, Status) {
 	var result int64
 	var lossless bool
 	status := Status(C.napi_get_value_bigint_int64(
@@ -389,7 +411,7 @@ func GetValueBigIntInt64(env Env, value Value) (int64, bool, Status) {
 	return result, lossless, status
 }
 
-func GetValueBigIntWords(env Env, value Value, signBit int, wordCount int, words *uint64) Status {
+func (cgoBackend) GetValueBigIntWords(env Env, value Value, signBit int, wordCount int, words *uint64) Status {
 	return Status(C.napi_get_value_bigint_words(
 		C.napi_env(env),
 		C.napi_value(value),
@@ -399,7 +421,7 @@ func GetValueBigIntWords(env Env, value Value, signBit int, wordCount int, words
 	))
 }
 
-func GetValueExternal(env Env, value Value) (unsafe.Pointer, Status) {
+func (cgoBackend) GetValueExternal(env Env, value Value) (unsafe.Pointer, Status) {
 	var result unsafe.Pointer
 	status := Status(C.napi_get_value_external(
 		C.napi_env(env),
@@ -409,7 +431,7 @@ func GetValueExternal(env Env, value Value) (unsafe.Pointer, Status) {
 	return result, status
 }
 
-func CoerceToBool(env Env, value Value) (Value, Status) {
+func (cgoBackend) CoerceToBool(env Env, value Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_coerce_to_bool(
 		C.napi_env(env),
@@ -419,7 +441,7 @@ func CoerceToBool(env Env, value Value) (Value, Status) {
 	return result, status
 }
 
-func CoerceToNumber(env Env, value Value) (Value, Status) {
+func (cgoBackend) CoerceToNumber(env Env, value Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_coerce_to_number(
 		C.napi_env(env),
@@ -429,7 +451,7 @@ func CoerceToNumber(env Env, value Value) (Value, Status) {
 	return result, status
 }
 
-func CoerceToObject(env Env, value Value) (Value, Status) {
+func (cgoBackend) CoerceToObject(env Env, value Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_coerce_to_object(
 		C.napi_env(env),
@@ -439,7 +461,7 @@ func CoerceToObject(env Env, value Value) (Value, Status) {
 	return result, status
 }
 
-func CoerceToString(env Env, value Value) (Value, Status) {
+func (cgoBackend) CoerceToString(env Env, value Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_coerce_to_string(
 		C.napi_env(env),
@@ -449,7 +471,7 @@ func CoerceToString(env Env, value Value) (Value, Status) {
 	return result, status
 }
 
-func CreateBuffer(env Env, length int) (Value, Status) {
+func (cgoBackend) CreateBuffer(env Env, length int) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_buffer(
 		C.napi_env(env),
@@ -460,46 +482,44 @@ func CreateBuffer(env Env, length int) (Value, Status) {
 	return result, status
 }
 
-func CreateBufferCopy(env Env, data []byte) (Value, Status) {
+func (cgoBackend) CreateBufferCopy(env Env, data []byte) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_buffer_copy(
 		C.napi_env(env),
 		C.size_t(len(data)),
-		unsafe.Pointer(&data[0]),
+		unsafe.Pointer(unsafe.SliceData(data)),
 		nil,
 		(*C.napi_value)(unsafe.Pointer(&result)),
 	))
 	return result, status
 }
 
-func GetBufferInfo(env Env, value Value) (*byte, int, Status) {
-	var data *byte
+func (cgoBackend) GetBufferInfo(env Env, value Value) (*byte, int, Status) {
+	var data unsafe.Pointer
 	var length C.size_t
 
-	dataPtr := unsafe.Pointer(&data)
-	status := Status(C.napi_get_buffer_info(C.napi_env(env), C.napi_value(value), &dataPtr, &length))
-	return data, int(length), status
+	status := Status(C.napi_get_buffer_info(C.napi_env(env), C.napi_value(value), &data, &length))
+	return (*byte)(data), int(length), status
 }
 
-func GetBufferInfoSize(env Env, value Value) (int, Status) {
+func (cgoBackend) GetBufferInfoSize(env Env, value Value) (int, Status) {
 	var length C.size_t
 	status := Status(C.napi_get_buffer_info(C.napi_env(env), C.napi_value(value), nil, &length))
 	return int(length), status
 }
 
-func GetBufferInfoData(env Env, value Value) (buff []byte, status Status) {
-	var data *byte
+func (cgoBackend) GetBufferInfoData(env Env, value Value) (buff []byte, status Status) {
+	var data unsafe.Pointer
 	var length C.size_t
 
-	dataPtr := unsafe.Pointer(&data)
-	status = Status(C.napi_get_buffer_info(C.napi_env(env), C.napi_value(value), &dataPtr, &length))
+	status = Status(C.napi_get_buffer_info(C.napi_env(env), C.napi_value(value), &data, &length))
 	if status == StatusOK {
-		buff = unsafe.Slice(data, length)
+		buff = unsafe.Slice((*byte)(data), length)
 	}
 	return
 }
 
-func GetArrayLength(env Env, value Value) (int, Status) {
+func (cgoBackend) GetArrayLength(env Env, value Value) (int, Status) {
 	var length C.uint32_t
 	status := Status(C.napi_get_array_length(
 		C.napi_env(env),
@@ -509,7 +529,7 @@ func GetArrayLength(env Env, value Value) (int, Status) {
 	return int(length), status
 }
 
-func GetPrototype(env Env, value Value) (Value, Status) {
+func (cgoBackend) GetPrototype(env Env, value Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_prototype(
 		C.napi_env(env),
@@ -519,7 +539,7 @@ func GetPrototype(env Env, value Value) (Value, Status) {
 	return result, status
 }
 
-func InstanceOf(env Env, object, constructor Value) (bool, Status) {
+func (cgoBackend) InstanceOf(env Env, object, constructor Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_instanceof(
 		C.napi_env(env),
@@ -530,7 +550,7 @@ func InstanceOf(env Env, object, constructor Value) (bool, Status) {
 	return result, status
 }
 
-func IsArray(env Env, value Value) (bool, Status) {
+func (cgoBackend) IsArray(env Env, value Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_is_array(
 		C.napi_env(env),
@@ -540,7 +560,7 @@ func IsArray(env Env, value Value) (bool, Status) {
 	return result, status
 }
 
-func IsBuffer(env Env, value Value) (bool, Status) {
+func (cgoBackend) IsBuffer(env Env, value Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_is_buffer(
 		C.napi_env(env),
@@ -550,7 +570,7 @@ func IsBuffer(env Env, value Value) (bool, Status) {
 	return result, status
 }
 
-func IsError(env Env, value Value) (bool, Status) {
+func (cgoBackend) IsError(env Env, value Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_is_error(
 		C.napi_env(env),
@@ -560,7 +580,7 @@ func IsError(env Env, value Value) (bool, Status) {
 	return result, status
 }
 
-func IsPromise(env Env, value Value) (bool, Status) {
+func (cgoBackend) IsPromise(env Env, value Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_is_promise(
 		C.napi_env(env),
@@ -570,7 +590,7 @@ func IsPromise(env Env, value Value) (bool, Status) {
 	return result, status
 }
 
-func IsTypedArray(env Env, value Value) (bool, Status) {
+func (cgoBackend) IsTypedArray(env Env, value Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_is_typedarray(
 		C.napi_env(env),
@@ -580,7 +600,7 @@ func IsTypedArray(env Env, value Value) (bool, Status) {
 	return result, status
 }
 
-func GetTypedArrayInfo(env Env, value Value) (TypedArrayType, int, *byte, Value, int, Status) {
+func (cgoBackend) GetTypedArrayInfo(env Env, value Value) (TypedArrayType, int, *byte, Value, int, Status) {
 	var type_ TypedArrayType
 	var length C.size_t
 	var data *byte
@@ -600,7 +620,7 @@ func GetTypedArrayInfo(env Env, value Value) (TypedArrayType, int, *byte, Value,
 	return type_, int(length), data, arrayBuffer, int(byteOffset), status
 }
 
-func CreateTypedArray(env Env, type_ TypedArrayType, length int, arrayBuffer Value, byteOffset int) (Value, Status) {
+func (cgoBackend) CreateTypedArray(env Env, type_ TypedArrayType, length int, arrayBuffer Value, byteOffset int) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_typedarray(
 		C.napi_env(env),
@@ -613,7 +633,7 @@ func CreateTypedArray(env Env, type_ TypedArrayType, length int, arrayBuffer Val
 	return result, status
 }
 
-func AdjustExternalMemory(env Env, change int64) (int64, Status) {
+func (cgoBackend) AdjustExternalMemory(env Env, change int64) (int64, Status) {
 	var result int64
 	status := Status(C.napi_adjust_external_memory(
 		C.napi_env(env),
@@ -623,7 +643,7 @@ func AdjustExternalMemory(env Env, change int64) (int64, Status) {
 	return result, status
 }
 
-func CreateDataView(env Env, length int, arrayBuffer Value, byteOffset int) (Value, Status) {
+func (cgoBackend) CreateDataView(env Env, length int, arrayBuffer Value, byteOffset int) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_dataview(
 		C.napi_env(env),
@@ -635,7 +655,7 @@ func CreateDataView(env Env, length int, arrayBuffer Value, byteOffset int) (Val
 	return result, status
 }
 
-func GetDataViewInfo(env Env, value Value) (int, *byte, Value, int, Status) {
+func (cgoBackend) GetDataViewInfo(env Env, value Value) (int, *byte, Value, int, Status) {
 	var length C.size_t
 	var data *byte
 	var arrayBuffer Value
@@ -653,7 +673,7 @@ func GetDataViewInfo(env Env, value Value) (int, *byte, Value, int, Status) {
 	return int(length), data, arrayBuffer, int(byteOffset), status
 }
 
-func GetAllPropertyNames(env Env, object Value, keyMode KeyCollectionMode, keyFilter KeyFilter, keyConversion KeyConversion) (Value, Status) {
+func (cgoBackend) GetAllPropertyNames(env Env, object Value, keyMode KeyCollectionMode, keyFilter KeyFilter, keyConversion KeyConversion) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_all_property_names(
 		C.napi_env(env),
@@ -666,7 +686,7 @@ func GetAllPropertyNames(env Env, object Value, keyMode KeyCollectionMode, keyFi
 	return result, status
 }
 
-func HasOwnProperty(env Env, object, key Value) (bool, Status) {
+func (cgoBackend) HasOwnProperty(env Env, object, key Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_has_own_property(
 		C.napi_env(env),
@@ -677,7 +697,7 @@ func HasOwnProperty(env Env, object, key Value) (bool, Status) {
 	return result, status
 }
 
-func HasProperty(env Env, object, key Value) (bool, Status) {
+func (cgoBackend) HasProperty(env Env, object, key Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_has_property(
 		C.napi_env(env),
@@ -688,7 +708,7 @@ func HasProperty(env Env, object, key Value) (bool, Status) {
 	return result, status
 }
 
-func GetPropertyNames(env Env, object Value) (Value, Status) {
+func (cgoBackend) GetPropertyNames(env Env, object Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_property_names(
 		C.napi_env(env),
@@ -698,7 +718,7 @@ func GetPropertyNames(env Env, object Value) (Value, Status) {
 	return result, status
 }
 
-func DefineProperties(env Env, object Value, properties []PropertyDescriptor) Status {
+func (cgoBackend) DefineProperties(env Env, object Value, properties []PropertyDescriptor) Status {
 	return Status(C.napi_define_properties(
 		C.napi_env(env),
 		C.napi_value(object),
@@ -707,7 +727,7 @@ func DefineProperties(env Env, object Value, properties []PropertyDescriptor) St
 	))
 }
 
-func GetValueBigIntUint64(env Env, value Value) (uint64, bool, Status) {
+func (cgoBackend) GetValueBigIntUint64(env Env, value Value) (uint64, bool, Status) {
 	var result uint64
 	var lossless bool
 	status := Status(C.napi_get_value_bigint_uint64(
@@ -719,7 +739,7 @@ func GetValueBigIntUint64(env Env, value Value) (uint64, bool, Status) {
 	return result, lossless, status
 }
 
-func CreateBigIntInt64(env Env, value int64) (Value, Status) {
+func (cgoBackend) CreateBigIntInt64(env Env, value int64) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_bigint_int64(
 		C.napi_env(env),
@@ -729,7 +749,7 @@ func CreateBigIntInt64(env Env, value int64) (Value, Status) {
 	return result, status
 }
 
-func CreateBigIntUint64(env Env, value uint64) (Value, Status) {
+func (cgoBackend) CreateBigIntUint64(env Env, value uint64) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_bigint_uint64(
 		C.napi_env(env),
@@ -739,7 +759,7 @@ func CreateBigIntUint64(env Env, value uint64) (Value, Status) {
 	return result, status
 }
 
-func CreateBigIntWords(env Env, signBit int, wordCount int, words *uint64) (Value, Status) {
+func (cgoBackend) CreateBigIntWords(env Env, signBit int, wordCount int, words *uint64) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_bigint_words(
 		C.napi_env(env),
@@ -751,7 +771,7 @@ func CreateBigIntWords(env Env, signBit int, wordCount int, words *uint64) (Valu
 	return result, status
 }
 
-func IsDate(env Env, value Value) (bool, Status) {
+func (cgoBackend) IsDate(env Env, value Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_is_date(
 		C.napi_env(env),
@@ -761,7 +781,7 @@ func IsDate(env Env, value Value) (bool, Status) {
 	return result, status
 }
 
-func IsDetachedArrayBuffer(env Env, value Value) (bool, Status) {
+func (cgoBackend) IsDetachedArrayBuffer(env Env, value Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_is_detached_arraybuffer(
 		C.napi_env(env),
@@ -771,14 +791,14 @@ func IsDetachedArrayBuffer(env Env, value Value) (bool, Status) {
 	return result, status
 }
 
-func DetachArrayBuffer(env Env, value Value) Status {
+func (cgoBackend) DetachArrayBuffer(env Env, value Value) Status {
 	return Status(C.napi_detach_arraybuffer(
 		C.napi_env(env),
 		C.napi_value(value),
 	))
 }
 
-func CreateArrayBuffer(env Env, length int) (Value, *byte, Status) {
+func (cgoBackend) CreateArrayBuffer(env Env, length int) (Value, *byte, Status) {
 	var result Value
 	var data *byte
 	dataPtr := unsafe.Pointer(&data)
@@ -791,7 +811,7 @@ func CreateArrayBuffer(env Env, length int) (Value, *byte, Status) {
 	return result, data, status
 }
 
-func GetArrayBufferInfo(env Env, value Value) (*byte, int, Status) {
+func (cgoBackend) GetArrayBufferInfo(env Env, value Value) (*byte, int, Status) {
 	var data *byte
 	var length C.size_t
 	dataPtr := unsafe.Pointer(&data)
@@ -804,21 +824,22 @@ func GetArrayBufferInfo(env Env, value Value) (*byte, int, Status) {
 	return data, int(length), status
 }
 
-func CreateExternalArrayBuffer(env Env, data unsafe.Pointer, length int, finalize Finalize, finalizeHint unsafe.Pointer) (Value, Status) {
+func (cgoBackend) CreateExternalArrayBuffer(env Env, data unsafe.Pointer, length int, finalize Finalize, finalizeHint unsafe.Pointer) (Value, Status) {
 	var result Value
-	finalizer := FinalizeToFinalizer(finalize)
+	cFinalize, cHint := finalizeOf(finalize, finalizeHint)
 	status := Status(C.napi_create_external_arraybuffer(
 		C.napi_env(env),
 		data,
 		C.size_t(length),
-		C.napi_finalize(unsafe.Pointer(&finalizer)),
-		finalizeHint,
+		cFinalize,
+		cHint,
 		(*C.napi_value)(unsafe.Pointer(&result)),
 	))
+	deleteFinalize(cFinalize, cHint, status)
 	return result, status
 }
 
-func GetElement(env Env, object Value, index int) (Value, Status) {
+func (cgoBackend) GetElement(env Env, object Value, index int) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_element(
 		C.napi_env(env),
@@ -829,7 +850,7 @@ func GetElement(env Env, object Value, index int) (Value, Status) {
 	return result, status
 }
 
-func GetProperty(env Env, object, key Value) (Value, Status) {
+func (cgoBackend) GetProperty(env Env, object, key Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_property(
 		C.napi_env(env),
@@ -840,7 +861,7 @@ func GetProperty(env Env, object, key Value) (Value, Status) {
 	return result, status
 }
 
-func DeleteProperty(env Env, object, key Value) (bool, Status) {
+func (cgoBackend) DeleteProperty(env Env, object, key Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_delete_property(
 		C.napi_env(env),
@@ -851,7 +872,7 @@ func DeleteProperty(env Env, object, key Value) (bool, Status) {
 	return result, status
 }
 
-func SetNamedProperty(env Env, object Value, name string, value Value) Status {
+func (cgoBackend) SetNamedProperty(env Env, object Value, name string, value Value) Status {
 	cname := C.CString(name)
 	defer C.free(unsafe.Pointer(cname))
 	return Status(C.napi_set_named_property(
@@ -862,7 +883,7 @@ func SetNamedProperty(env Env, object Value, name string, value Value) Status {
 	))
 }
 
-func GetNamedProperty(env Env, object Value, name string) (Value, Status) {
+func (cgoBackend) GetNamedProperty(env Env, object Value, name string) (Value, Status) {
 	cname := C.CString(name)
 	defer C.free(unsafe.Pointer(cname))
 	var result Value
@@ -875,7 +896,7 @@ func GetNamedProperty(env Env, object Value, name string) (Value, Status) {
 	return result, status
 }
 
-func HasNamedProperty(env Env, object Value, name string) (bool, Status) {
+func (cgoBackend) HasNamedProperty(env Env, object Value, name string) (bool, Status) {
 	cname := C.CString(name)
 	defer C.free(unsafe.Pointer(cname))
 	var result bool
@@ -888,7 +909,7 @@ func HasNamedProperty(env Env, object Value, name string) (bool, Status) {
 	return result, status
 }
 
-func HasElement(env Env, object Value, index int) (bool, Status) {
+func (cgoBackend) HasElement(env Env, object Value, index int) (bool, Status) {
 	var result bool
 	status := Status(C.napi_has_element(
 		C.napi_env(env),
@@ -899,7 +920,7 @@ func HasElement(env Env, object Value, index int) (bool, Status) {
 	return result, status
 }
 
-func DeleteElement(env Env, object Value, index int) (bool, Status) {
+func (cgoBackend) DeleteElement(env Env, object Value, index int) (bool, Status) {
 	var result bool
 	status := Status(C.napi_delete_element(
 		C.napi_env(env),
@@ -910,21 +931,21 @@ func DeleteElement(env Env, object Value, index int) (bool, Status) {
 	return result, status
 }
 
-func ObjectFreeze(env Env, object Value) Status {
+func (cgoBackend) ObjectFreeze(env Env, object Value) Status {
 	return Status(C.napi_object_freeze(
 		C.napi_env(env),
 		C.napi_value(object),
 	))
 }
 
-func ObjectSeal(env Env, object Value) Status {
+func (cgoBackend) ObjectSeal(env Env, object Value) Status {
 	return Status(C.napi_object_seal(
 		C.napi_env(env),
 		C.napi_value(object),
 	))
 }
 
-func ThrowTypeError(env Env, code, msg string) Status {
+func (cgoBackend) ThrowTypeError(env Env, code, msg string) Status {
 	codeCStr, msgCCstr := C.CString(code), C.CString(msg)
 	defer C.free(unsafe.Pointer(codeCStr))
 	defer C.free(unsafe.Pointer(msgCCstr))
@@ -936,7 +957,7 @@ func ThrowTypeError(env Env, code, msg string) Status {
 	))
 }
 
-func ThrowRangeError(env Env, code, msg string) Status {
+func (cgoBackend) ThrowRangeError(env Env, code, msg string) Status {
 	codeCStr, msgCCstr := C.CString(code), C.CString(msg)
 	defer C.free(unsafe.Pointer(codeCStr))
 	defer C.free(unsafe.Pointer(msgCCstr))
@@ -948,7 +969,7 @@ func ThrowRangeError(env Env, code, msg string) Status {
 	))
 }
 
-func CreateTypeError(env Env, code, msg Value) (Value, Status) {
+func (cgoBackend) CreateTypeError(env Env, code, msg Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_type_error(
 		C.napi_env(env),
@@ -959,7 +980,7 @@ func CreateTypeError(env Env, code, msg Value) (Value, Status) {
 	return result, status
 }
 
-func CreateRangeError(env Env, code, msg Value) (Value, Status) {
+func (cgoBackend) CreateRangeError(env Env, code, msg Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_range_error(
 		C.napi_env(env),
@@ -970,7 +991,7 @@ func CreateRangeError(env Env, code, msg Value) (Value, Status) {
 	return result, status
 }
 
-func IsExceptionPending(env Env) (bool, Status) {
+func (cgoBackend) IsExceptionPending(env Env) (bool, Status) {
 	var result bool
 	status := Status(C.napi_is_exception_pending(
 		C.napi_env(env),
@@ -979,7 +1000,7 @@ func IsExceptionPending(env Env) (bool, Status) {
 	return result, status
 }
 
-func GetAndClearLastException(env Env) (Value, Status) {
+func (cgoBackend) GetAndClearLastException(env Env) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_and_clear_last_exception(
 		C.napi_env(env),
@@ -988,19 +1009,15 @@ func GetAndClearLastException(env Env) (Value, Status) {
 	return result, status
 }
 
-type CallbackScope struct {
-	scope C.napi_callback_scope
-}
-
 // CloseCallbackScope Function to close a callback scope
-func CloseCallbackScope(env Env, scope CallbackScope) Status {
+func (cgoBackend) CloseCallbackScope(env Env, scope CallbackScope) Status {
 	return Status(C.napi_close_callback_scope(
 		C.napi_env(env),
-		scope.scope,
+		C.napi_callback_scope(scope.scope),
 	))
 }
 
-func CreateInt32(env Env, value int32) (Value, Status) {
+func (cgoBackend) CreateInt32(env Env, value int32) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_int32(
 		C.napi_env(env),
@@ -1010,7 +1027,7 @@ func CreateInt32(env Env, value int32) (Value, Status) {
 	return result, status
 }
 
-func CreateUint32(env Env, value uint32) (Value, Status) {
+func (cgoBackend) CreateUint32(env Env, value uint32) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_uint32(
 		C.napi_env(env),
@@ -1020,7 +1037,7 @@ func CreateUint32(env Env, value uint32) (Value, Status) {
 	return result, status
 }
 
-func CreateInt64(env Env, value int64) (Value, Status) {
+func (cgoBackend) CreateInt64(env Env, value int64) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_int64(
 		C.napi_env(env),
@@ -1030,7 +1047,7 @@ func CreateInt64(env Env, value int64) (Value, Status) {
 	return result, status
 }
 
-func CreateStringLatin1(env Env, str string) (Value, Status) {
+func (cgoBackend) CreateStringLatin1(env Env, str string) (Value, Status) {
 	cstr := C.CString(str)
 	defer C.free(unsafe.Pointer(cstr))
 
@@ -1044,7 +1061,7 @@ func CreateStringLatin1(env Env, str string) (Value, Status) {
 	return result, status
 }
 
-func CreateStringUtf16(env Env, str []uint16) (Value, Status) {
+func (cgoBackend) CreateStringUtf16(env Env, str []uint16) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_string_utf16(
 		C.napi_env(env),
@@ -1055,20 +1072,35 @@ func CreateStringUtf16(env Env, str []uint16) (Value, Status) {
 	return result, status
 }
 
-func CallFunction(env Env, recv Value, fn Value, argc int, argv []Value) (Value, Status) {
+func (cgoBackend) CallFunction(env Env, recv Value, fn Value, argc int, argv []Value) (Value, Status) {
+	var cArgv unsafe.Pointer
+	if argc > 0 {
+		cArgv = unsafe.Pointer(&argv[0]) // must pass element pointer
+	}
+
 	var result Value
 	status := Status(C.napi_call_function(
 		C.napi_env(env),
 		C.napi_value(recv),
 		C.napi_value(fn),
 		C.size_t(argc),
-		(*C.napi_value)(unsafe.Pointer(&argv[0])),
+		(*C.napi_value)(cArgv),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func (cgoBackend) RunScript(env Env, script Value) (Value, Status) {
+	var result Value
+	status := Status(C.napi_run_script(
+		C.napi_env(env),
+		C.napi_value(script),
 		(*C.napi_value)(unsafe.Pointer(&result)),
 	))
 	return result, status
 }
 
-func GetNewTarget(env Env, info CallbackInfo) (Value, Status) {
+func (cgoBackend) GetNewTarget(env Env, info CallbackInfo) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_new_target(
 		C.napi_env(env),
@@ -1078,19 +1110,24 @@ func GetNewTarget(env Env, info CallbackInfo) (Value, Status) {
 	return result, status
 }
 
-func NewInstance(env Env, constructor Value, argc int, argv []Value) (Value, Status) {
+func (cgoBackend) NewInstance(env Env, constructor Value, argc int, argv []Value) (Value, Status) {
+	var cArgv unsafe.Pointer
+	if argc > 0 {
+		cArgv = unsafe.Pointer(&argv[0]) // must pass element pointer
+	}
+
 	var result Value
 	status := Status(C.napi_new_instance(
 		C.napi_env(env),
 		C.napi_value(constructor),
 		C.size_t(argc),
-		(*C.napi_value)(unsafe.Pointer(&argv[0])),
+		(*C.napi_value)(cArgv),
 		(*C.napi_value)(unsafe.Pointer(&result)),
 	))
 	return result, status
 }
 
-func IsDataView(env Env, value Value) (bool, Status) {
+func (cgoBackend) IsDataView(env Env, value Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_is_dataview(
 		C.napi_env(env),
@@ -1100,7 +1137,7 @@ func IsDataView(env Env, value Value) (bool, Status) {
 	return result, status
 }
 
-func IsArrayBuffer(env Env, value Value) (bool, Status) {
+func (cgoBackend) IsArrayBuffer(env Env, value Value) (bool, Status) {
 	var result bool
 	status := Status(C.napi_is_arraybuffer(
 		C.napi_env(env),
@@ -1110,7 +1147,7 @@ func IsArrayBuffer(env Env, value Value) (bool, Status) {
 	return result, status
 }
 
-func GetDateValue(env Env, value Value) (float64, Status) {
+func (cgoBackend) GetDateValue(env Env, value Value) (float64, Status) {
 	var result float64
 	status := Status(C.napi_get_date_value(
 		C.napi_env(env),
@@ -1120,7 +1157,7 @@ func GetDateValue(env Env, value Value) (float64, Status) {
 	return result, status
 }
 
-func CreateDate(env Env, time float64) (Value, Status) {
+func (cgoBackend) CreateDate(env Env, time float64) (Value, Status) {
 	var result Value
 	status := Status(C.napi_create_date(
 		C.napi_env(env),
@@ -0,0 +1,48 @@
+//go:build !napi8 && !napi9
+
+package napi
+
+// #include <stdlib.h>
+// #include <node/node_api.h>
+import "C"
+
+import "unsafe"
+
+func (cgoBackend) CreatePropertyKeyLatin1(env Env, str string) (Value, Status) {
+	cstr := C.CString(str)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var result Value
+	status := Status(C.node_api_create_property_key_latin1(
+		C.napi_env(env),
+		cstr,
+		C.size_t(len([]byte(str))),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func (cgoBackend) CreatePropertyKeyUtf16(env Env, str []uint16) (Value, Status) {
+	var result Value
+	status := Status(C.node_api_create_property_key_utf16(
+		C.napi_env(env),
+		(*C.char16_t)(unsafe.Pointer(&str[0])),
+		C.size_t(len(str)),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func (cgoBackend) CreatePropertyKeyUtf8(env Env, str string) (Value, Status) {
+	cstr := C.CString(str)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var result Value
+	status := Status(C.node_api_create_property_key_utf8(
+		C.napi_env(env),
+		cstr,
+		C.size_t(len([]byte(str))),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
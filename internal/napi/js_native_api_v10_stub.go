@@ -0,0 +1,17 @@
+//go:build cgo && (napi8 || napi9)
+
+package napi
+
+// Node-API 10 functions compiled out, building with napi8 or napi9 tag
+
+func (cgoBackend) CreatePropertyKeyLatin1(env Env, str string) (Value, Status) {
+	return nil, StatusGenericFailure
+}
+
+func (cgoBackend) CreatePropertyKeyUtf16(env Env, str []uint16) (Value, Status) {
+	return nil, StatusGenericFailure
+}
+
+func (cgoBackend) CreatePropertyKeyUtf8(env Env, str string) (Value, Status) {
+	return nil, StatusGenericFailure
+}
@@ -1,25 +1,22 @@
 package napi
 
-// #include <node/node_api.h>
-import "C"
-
 type (
-	KeyCollectionMode C.napi_key_collection_mode // KeyCollectionMode type
-	KeyFilter         C.napi_key_filter          // KeyFilter type
-	KeyConversion     C.napi_key_conversion      // KeyConversion type
+	KeyCollectionMode uint32 // KeyCollectionMode type, napi_key_collection_mode
+	KeyFilter         uint32 // KeyFilter type, napi_key_filter
+	KeyConversion     uint32 // KeyConversion type, napi_key_conversion
 )
 
 const (
-	KeyIncludePrototypes KeyCollectionMode = C.napi_key_include_prototypes
-	KeyOwnOnly           KeyCollectionMode = C.napi_key_own_only
+	KeyIncludePrototypes KeyCollectionMode = 0 // napi_key_include_prototypes
+	KeyOwnOnly           KeyCollectionMode = 1 // napi_key_own_only
 
-	KeyAllProperties KeyFilter = C.napi_key_all_properties
-	KeyWritable      KeyFilter = C.napi_key_writable
-	KeyEnumerable    KeyFilter = C.napi_key_enumerable
-	KeyConfigurable  KeyFilter = C.napi_key_configurable
-	KeySkipStrings   KeyFilter = C.napi_key_skip_strings
-	KeySkipSymbols   KeyFilter = C.napi_key_skip_symbols
+	KeyAllProperties KeyFilter = 0      // napi_key_all_properties
+	KeyWritable      KeyFilter = 1      // napi_key_writable
+	KeyEnumerable    KeyFilter = 1 << 1 // napi_key_enumerable
+	KeyConfigurable  KeyFilter = 1 << 2 // napi_key_configurable
+	KeySkipStrings   KeyFilter = 1 << 3 // napi_key_skip_strings
+	KeySkipSymbols   KeyFilter = 1 << 4 // napi_key_skip_symbols
 
-	KeyKeepNumbers      KeyConversion = C.napi_key_keep_numbers
-	KeyNumbersToStrings KeyConversion = C.napi_key_numbers_to_strings
+	KeyKeepNumbers      KeyConversion = 0 // napi_key_keep_numbers
+	KeyNumbersToStrings KeyConversion = 1 // napi_key_numbers_to_strings
 )
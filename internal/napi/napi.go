@@ -1,26 +1,6 @@
 // N-API used by [sirherobrine23.com.br/Sirherobrine23/napi-go], only use in internal, don't export
 package napi
 
-/*
-#cgo CFLAGS: -DDEBUG
-#cgo CFLAGS: -D_DEBUG
-#cgo CFLAGS: -DV8_ENABLE_CHECKS
-#cgo CFLAGS: -DNAPI_EXPERIMENTAL
-#cgo CFLAGS: -I/usr/local/include/node
-#cgo CXXFLAGS: -std=c++11
-
-#cgo darwin LDFLAGS: -Wl,-undefined,dynamic_lookup
-#cgo darwin LDFLAGS: -Wl,-no_pie
-#cgo darwin LDFLAGS: -Wl,-search_paths_first
-#cgo (darwin && amd64) LDFLAGS: -arch x86_64
-#cgo (darwin && arm64) LDFLAGS: -arch arm64
-
-#cgo linux LDFLAGS: -Wl,-unresolved-symbols=ignore-all
-
-#cgo LDFLAGS: -L${SRCDIR}
-*/
-import "C"
-
 import "unsafe"
 
 type (
@@ -30,3 +10,56 @@ type (
 	// This is an opaque pointer that is used to represent a JavaScript value.
 	Value unsafe.Pointer
 )
+
+type (
+	// Opaque datatype that is passed to a callback function. It can be used for getting additional information about the context in which the callback was invoked.
+	CallbackInfo unsafe.Pointer
+
+	// Go function called by Javascript function created with CreateFunction.
+	Callback func(env Env, info CallbackInfo) Value
+
+	// Function pointer type for add-on provided function that allow the user to schedule a group of calls to Node-APIs in response to a garbage collection event, after the garbage collection cycle has completed. These function pointers can be used with node_api_post_finalizer.
+	Finalize func(env Env, finalizeData, finalizeHint unsafe.Pointer)
+
+	// Deferred of promise, created with CreatePromise.
+	Deferred unsafe.Pointer
+)
+
+type GetCbInfoResult struct {
+	Args []Value
+	This Value
+}
+
+type CallbackScope struct {
+	scope unsafe.Pointer
+}
+
+type Reference struct {
+	Ref unsafe.Pointer
+}
+
+type EscapableHandleScope struct {
+	Scope unsafe.Pointer
+}
+
+type HandleScope struct {
+	Scope unsafe.Pointer
+}
+
+type AsyncWork struct {
+	Handle unsafe.Pointer
+	ID     NapiGoAsyncWorkID
+}
+
+type NapiGoAsyncWorkID int
+
+type AsyncExecuteCallback func(env Env)
+
+type AsyncCompleteCallback func(env Env, status Status)
+
+type NodeVersion struct {
+	Major   uint
+	Minor   uint
+	Patch   uint
+	Release string
+}
@@ -0,0 +1,60 @@
+//go:build !napi8
+
+package napi
+
+// #include <stdlib.h>
+// #include <node/node_api.h>
+import "C"
+
+import "unsafe"
+
+func (cgoBackend) GetModuleFileName(env Env) (string, Status) {
+	var cresult *C.char
+	status := Status(C.node_api_get_module_file_name(
+		C.napi_env(env),
+		(**C.char)(&cresult),
+	))
+
+	if status != StatusOK {
+		return "", status
+	}
+
+	return C.GoString(cresult), status
+}
+
+func (cgoBackend) ThrowSyntaxError(env Env, code, msg string) Status {
+	codeCStr, msgCStr := C.CString(code), C.CString(msg)
+	defer C.free(unsafe.Pointer(codeCStr))
+	defer C.free(unsafe.Pointer(msgCStr))
+
+	return Status(C.node_api_throw_syntax_error(
+		C.napi_env(env),
+		codeCStr,
+		msgCStr,
+	))
+}
+
+func (cgoBackend) CreateSyntaxError(env Env, code, msg Value) (Value, Status) {
+	var result Value
+	status := Status(C.node_api_create_syntax_error(
+		C.napi_env(env),
+		C.napi_value(code),
+		C.napi_value(msg),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
+
+func (cgoBackend) SymbolFor(env Env, description string) (Value, Status) {
+	cdescription := C.CString(description)
+	defer C.free(unsafe.Pointer(cdescription))
+
+	var result Value
+	status := Status(C.node_api_symbol_for(
+		C.napi_env(env),
+		cdescription,
+		C.size_t(len(description)),
+		(*C.napi_value)(unsafe.Pointer(&result)),
+	))
+	return result, status
+}
@@ -0,0 +1,17 @@
+//go:build cgo && napi8
+
+package napi
+
+// Node-API 9 functions compiled out, building with napi8 tag
+
+func (cgoBackend) GetModuleFileName(env Env) (string, Status) { return "", StatusGenericFailure }
+
+func (cgoBackend) ThrowSyntaxError(env Env, code, msg string) Status { return StatusGenericFailure }
+
+func (cgoBackend) CreateSyntaxError(env Env, code, msg Value) (Value, Status) {
+	return nil, StatusGenericFailure
+}
+
+func (cgoBackend) SymbolFor(env Env, description string) (Value, Status) {
+	return nil, StatusGenericFailure
+}
@@ -1,8 +1,5 @@
 package napi
 
-// #include <node/node_api.h>
-import "C"
-
 import (
 	"fmt"
 	"unsafe"
@@ -27,45 +24,29 @@ func (err StatusError) Error() string {
 // Currently, the following status codes are supported.
 type Status int
 
-// GetExtendedErrorInfo Function to retrieve extended error information
-func GetExtendedErrorInfo(env Env) (*ExtendedError, Status) {
-	var errorInfo *C.napi_extended_error_info
-	status := Status(C.napi_get_last_error_info(C.napi_env(env), &errorInfo))
-	var extendErr *ExtendedError
-	if status == StatusOK {
-		extendErr = &ExtendedError{
-			Message:         C.GoString(errorInfo.error_message),
-			StatusCode:      Status(errorInfo.error_code),
-			EngineErrorCode: int32(errorInfo.engine_error_code),
-			EngineReserved:  errorInfo.engine_reserved,
-		}
-	}
-	return extendErr, status
-}
-
 const (
-	StatusOK                            Status = C.napi_ok
-	StatusInvalidArg                    Status = C.napi_invalid_arg
-	StatusObjectExpected                Status = C.napi_object_expected
-	StatusStringExpected                Status = C.napi_string_expected
-	StatusNameExpected                  Status = C.napi_name_expected
-	StatusFunctionExpected              Status = C.napi_function_expected
-	StatusNumberExpected                Status = C.napi_number_expected
-	StatusBooleanExpected               Status = C.napi_boolean_expected
-	StatusArrayExpected                 Status = C.napi_array_expected
-	StatusGenericFailure                Status = C.napi_generic_failure
-	StatusPendingException              Status = C.napi_pending_exception
-	StatusCancelled                     Status = C.napi_cancelled
-	StatusEscapeCalledTwice             Status = C.napi_escape_called_twice
-	StatusHandleScopeMismatch           Status = C.napi_handle_scope_mismatch
-	StatusCallbackScopeMismatch         Status = C.napi_callback_scope_mismatch
-	StatusQueueFull                     Status = C.napi_queue_full
-	StatusClosing                       Status = C.napi_closing
-	StatusBigintExpected                Status = C.napi_bigint_expected
-	StatusDateExpected                  Status = C.napi_date_expected
-	StatusArraybufferExpected           Status = C.napi_arraybuffer_expected
-	StatusDetachableArraybufferExpected Status = C.napi_detachable_arraybuffer_expected
-	StatusWouldDeadlock                 Status = C.napi_would_deadlock
+	StatusOK                            Status = iota // napi_ok
+	StatusInvalidArg                                  // napi_invalid_arg
+	StatusObjectExpected                              // napi_object_expected
+	StatusStringExpected                              // napi_string_expected
+	StatusNameExpected                                // napi_name_expected
+	StatusFunctionExpected                            // napi_function_expected
+	StatusNumberExpected                              // napi_number_expected
+	StatusBooleanExpected                             // napi_boolean_expected
+	StatusArrayExpected                               // napi_array_expected
+	StatusGenericFailure                              // napi_generic_failure
+	StatusPendingException                            // napi_pending_exception
+	StatusCancelled                                   // napi_cancelled
+	StatusEscapeCalledTwice                           // napi_escape_called_twice
+	StatusHandleScopeMismatch                         // napi_handle_scope_mismatch
+	StatusCallbackScopeMismatch                       // napi_callback_scope_mismatch
+	StatusQueueFull                                   // napi_queue_full
+	StatusClosing                                     // napi_closing
+	StatusBigintExpected                              // napi_bigint_expected
+	StatusDateExpected                                // napi_date_expected
+	StatusArraybufferExpected                         // napi_arraybuffer_expected
+	StatusDetachableArraybufferExpected               // napi_detachable_arraybuffer_expected
+	StatusWouldDeadlock                               // napi_would_deadlock
 )
 
 // Return error if Status is not StatusOK
@@ -1,63 +1,136 @@
 package napi
 
-// #include <node/node_api.h>
-import "C"
+/*
+#include <stdint.h>
+#include <node/node_api.h>
 
-import "unsafe"
+extern void ExecuteThreadsafeFunctionCallJS(
+	napi_env env,
+	napi_value js_callback,
+	void *context,
+	void *data
+);
 
-type (
-	ThreadsafeFunction            unsafe.Pointer
-	ThreadsafeFunctionReleaseMode C.napi_threadsafe_function_release_mode
-	ThreadsafeFunctionCallMode    C.napi_threadsafe_function_call_mode
-)
+extern void FinalizeThreadsafeFunction(
+	napi_env env,
+	void *finalize_data,
+	void *finalize_hint
+);
 
-const (
-	Release     ThreadsafeFunctionReleaseMode = C.napi_tsfn_release
-	Abort       ThreadsafeFunctionReleaseMode = C.napi_tsfn_abort
-	NonBlocking ThreadsafeFunctionCallMode    = C.napi_tsfn_nonblocking
-	Blocking    ThreadsafeFunctionCallMode    = C.napi_tsfn_blocking
+// Go callbacks are cgo.Handle, passed to context and finalize data as pointer
+static napi_status createThreadsafeFunction(napi_env env, napi_value func, napi_value async_resource, napi_value async_resource_name, size_t max_queue_size, size_t initial_thread_count, uintptr_t callbacks, napi_threadsafe_function* result) {
+	return napi_create_threadsafe_function(env, func, async_resource, async_resource_name, max_queue_size, initial_thread_count, (void*)callbacks, FinalizeThreadsafeFunction, (void*)callbacks, ExecuteThreadsafeFunctionCallJS, result);
+}
+
+// Data is cgo.Handle, passed to Node-API as pointer
+static napi_status callThreadsafeFunction(napi_threadsafe_function func, uintptr_t data, napi_threadsafe_function_call_mode mode) {
+	return napi_call_threadsafe_function(func, (void*)data, mode);
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"runtime/cgo"
+	"unsafe"
 )
 
-func CreateThreadsafeFunction(env Env, fn, asyncResource, asyncResourceName Value, maxQueueSize, initialThreadCount int) (ThreadsafeFunction, Status) {
+// Go callbacks of threadsafe function
+type threadsafeFunctionCallbacks struct {
+	finalize ThreadsafeFunctionFinalize
+	callJS   ThreadsafeFunctionCallJS
+}
+
+//export ExecuteThreadsafeFunctionCallJS
+func ExecuteThreadsafeFunctionCallJS(cEnv C.napi_env, cJsCallback C.napi_value, context, data unsafe.Pointer) {
+	env := Env(cEnv)
+	dataHandle := cgo.Handle(data)
+	value := dataHandle.Value()
+	dataHandle.Delete()
+	if env == nil {
+		return // Threadsafe function finalizing, queue dropped
+	}
+
+	defer func() {
+		err := recover()
+		if err != nil {
+			ThrowError(env, "", fmt.Sprintf("napi.ExecuteThreadsafeFunctionCallJS: Recovered from panic: %s\n", err))
+		}
+	}()
+
+	callbacks := cgo.Handle(context).Value().(*threadsafeFunctionCallbacks)
+	if callbacks.callJS != nil {
+		callbacks.callJS(env, Value(cJsCallback), value)
+	}
+}
+
+//export FinalizeThreadsafeFunction
+func FinalizeThreadsafeFunction(cEnv C.napi_env, finalizeData, finalizeHint unsafe.Pointer) {
+	env := Env(cEnv)
+	handle := cgo.Handle(finalizeData)
+	defer handle.Delete()
+	defer func() {
+		err := recover()
+		if err != nil {
+			ThrowError(env, "", fmt.Sprintf("napi.FinalizeThreadsafeFunction: Recovered from panic: %s\n", err))
+		}
+	}()
+
+	callbacks := handle.Value().(*threadsafeFunctionCallbacks)
+	if callbacks.finalize != nil {
+		callbacks.finalize(env)
+	}
+}
+
+// Create threadsafe function calling Go functions in Javascript thread, fn is optional if callJS not call it.
+func (cgoBackend) CreateThreadsafeFunction(env Env, fn, asyncResource, asyncResourceName Value, maxQueueSize, initialThreadCount int, finalize ThreadsafeFunctionFinalize, callJS ThreadsafeFunctionCallJS) (ThreadsafeFunction, Status) {
+	handle := cgo.NewHandle(&threadsafeFunctionCallbacks{finalize: finalize, callJS: callJS})
+
 	var result ThreadsafeFunction
-	status := Status(C.napi_create_threadsafe_function(
+	status := Status(C.createThreadsafeFunction(
 		C.napi_env(env),
 		C.napi_value(fn),
 		C.napi_value(asyncResource),
 		C.napi_value(asyncResourceName),
 		C.size_t(maxQueueSize),
 		C.size_t(initialThreadCount),
-		nil,
-		nil,
-		nil,
-		nil,
+		C.uintptr_t(handle),
 		(*C.napi_threadsafe_function)(unsafe.Pointer(&result)),
 	))
+	if status != StatusOK {
+		handle.Delete()
+	}
 	return result, status
 }
 
-func CallThreadsafeFunction(fn ThreadsafeFunction, mode ThreadsafeFunctionCallMode) Status {
-	return Status(C.napi_call_threadsafe_function(
+// Queue data to callJS of threadsafe function, safe to call from any thread.
+func (cgoBackend) CallThreadsafeFunction(fn ThreadsafeFunction, data any, mode ThreadsafeFunctionCallMode) Status {
+	handle := cgo.NewHandle(data)
+	status := Status(C.callThreadsafeFunction(
 		C.napi_threadsafe_function(fn),
-		nil,
+		C.uintptr_t(handle),
 		C.napi_threadsafe_function_call_mode(mode),
 	))
+	if status != StatusOK {
+		handle.Delete()
+	}
+	return status
 }
 
-func AcquireThreadsafeFunction(fn ThreadsafeFunction) Status {
+func (cgoBackend) AcquireThreadsafeFunction(fn ThreadsafeFunction) Status {
 	return Status(C.napi_acquire_threadsafe_function(
 		C.napi_threadsafe_function(fn),
 	))
 }
 
-func ReleaseThreadsafeFunction(fn ThreadsafeFunction, mode ThreadsafeFunctionReleaseMode) Status {
+func (cgoBackend) ReleaseThreadsafeFunction(fn ThreadsafeFunction, mode ThreadsafeFunctionReleaseMode) Status {
 	return Status(C.napi_release_threadsafe_function(
 		C.napi_threadsafe_function(fn),
 		C.napi_threadsafe_function_release_mode(mode),
 	))
 }
 
-func GetThreadsafeFunctionContext(fn ThreadsafeFunction) (unsafe.Pointer, Status) {
+func (cgoBackend) GetThreadsafeFunctionContext(fn ThreadsafeFunction) (unsafe.Pointer, Status) {
 	var context unsafe.Pointer
 	status := Status(C.napi_get_threadsafe_function_context(
 		C.napi_threadsafe_function(fn),
@@ -66,14 +139,14 @@ func GetThreadsafeFunctionContext(fn ThreadsafeFunction) (unsafe.Pointer, Status
 	return context, status
 }
 
-func RefThreadsafeFunction(env Env, fn ThreadsafeFunction) Status {
+func (cgoBackend) RefThreadsafeFunction(env Env, fn ThreadsafeFunction) Status {
 	return Status(C.napi_ref_threadsafe_function(
 		C.napi_env(env),
 		C.napi_threadsafe_function(fn),
 	))
 }
 
-func UnrefThreadsafeFunction(env Env, fn ThreadsafeFunction) Status {
+func (cgoBackend) UnrefThreadsafeFunction(env Env, fn ThreadsafeFunction) Status {
 	return Status(C.napi_unref_threadsafe_function(
 		C.napi_env(env),
 		C.napi_threadsafe_function(fn),
@@ -0,0 +1,23 @@
+package napi
+
+import "unsafe"
+
+type (
+	ThreadsafeFunction            unsafe.Pointer
+	ThreadsafeFunctionReleaseMode uint32 // napi_threadsafe_function_release_mode
+	ThreadsafeFunctionCallMode    uint32 // napi_threadsafe_function_call_mode
+)
+
+const (
+	Release     ThreadsafeFunctionReleaseMode = 0 // napi_tsfn_release
+	Abort       ThreadsafeFunctionReleaseMode = 1 // napi_tsfn_abort
+	NonBlocking ThreadsafeFunctionCallMode    = 0 // napi_tsfn_nonblocking
+	Blocking    ThreadsafeFunctionCallMode    = 1 // napi_tsfn_blocking
+)
+
+// Go function called in Javascript thread with data passed to CallThreadsafeFunction,
+// not called to data dropped from queue when threadsafe function is finalized.
+type ThreadsafeFunctionCallJS func(env Env, jsCallback Value, data any)
+
+// Go function called in Javascript thread when threadsafe function is finalized.
+type ThreadsafeFunctionFinalize func(env Env)
@@ -1,27 +1,56 @@
 package napi
 
-// #include <node/node_api.h>
+/*
+#include <stdint.h>
+#include <node/node_api.h>
+
+extern void ExecuteFinalize(
+	napi_env env,
+	void *finalize_data,
+	void *finalize_hint
+);
+
+// Go finalize is cgo.Handle, passed to Node-API as finalize hint
+static inline void *finalizeHint(uintptr_t handle) { return (void*)handle; }
+*/
 import "C"
 
-import "unsafe"
+import (
+	"runtime/cgo"
+	"unsafe"
+)
 
-// Function pointer type for add-on provided function that allow the user to schedule a group of calls to Node-APIs in response to a garbage collection event, after the garbage collection cycle has completed. These function pointers can be used with node_api_post_finalizer.
-type Finalize func(env Env, finalizeData, finalizeHint unsafe.Pointer)
+// Go finalize and hint of value, stored in cgo.Handle passed as finalize hint to Node-API
+type finalizeData struct {
+	finalize Finalize
+	hint     unsafe.Pointer
+}
 
-// Finalizer as a C-compatible function pointer type
-type Finalizer func(env C.napi_env, finalizeData, finalizeHint unsafe.Pointer)
+//export ExecuteFinalize
+func ExecuteFinalize(cEnv C.napi_env, data, hint unsafe.Pointer) {
+	handle := cgo.Handle(hint)
+	defer handle.Delete()
+	fn := handle.Value().(*finalizeData)
+	fn.finalize(Env(cEnv), data, fn.hint)
+}
 
-func FinalizeToFinalizer(fn Finalize) Finalizer {
-	return func(env C.napi_env, finalizeData, finalizeHint unsafe.Pointer) {
-		fn(Env(env), finalizeData, finalizeHint)
+// Return C finalize and hint to call finalize, finalize is optional
+func finalizeOf(finalize Finalize, hint unsafe.Pointer) (C.napi_finalize, unsafe.Pointer) {
+	if finalize == nil {
+		return nil, hint
 	}
+	handle := cgo.NewHandle(&finalizeData{finalize: finalize, hint: hint})
+	return C.napi_finalize(C.ExecuteFinalize), C.finalizeHint(C.uintptr_t(handle))
 }
 
-type Reference struct {
-	Ref unsafe.Pointer
+// Delete Go finalize of finalizeOf if Node-API call failed
+func deleteFinalize(cFinalize C.napi_finalize, hint unsafe.Pointer, status Status) {
+	if cFinalize != nil && status != StatusOK {
+		cgo.Handle(uintptr(hint)).Delete()
+	}
 }
 
-func CreateReference(env Env, value Value, initialRefcount int) (Reference, Status) {
+func (cgoBackend) CreateReference(env Env, value Value, initialRefcount int) (Reference, Status) {
 	var ref Reference
 	status := Status(C.napi_create_reference(
 		C.napi_env(env),
@@ -32,24 +61,24 @@ func CreateReference(env Env, value Value, initialRefcount int) (Reference, Stat
 	return ref, status
 }
 
-func DeleteReference(env Env, ref Reference) Status {
+func (cgoBackend) DeleteReference(env Env, ref Reference) Status {
 	return Status(C.napi_delete_reference(
 		C.napi_env(env),
-		C.napi_ref(unsafe.Pointer(&ref)),
+		C.napi_ref(ref.Ref),
 	))
 }
 
-func ReferenceRef(env Env, ref Reference) (int, Status) {
+func (cgoBackend) ReferenceRef(env Env, ref Reference) (int, Status) {
 	var result C.uint32_t
 	status := Status(C.napi_reference_ref(
 		C.napi_env(env),
-		C.napi_ref(unsafe.Pointer(&ref)),
+		C.napi_ref(ref.Ref),
 		&result,
 	))
 	return int(result), status
 }
 
-func ReferenceUnref(env Env, ref Reference) (int, Status) {
+func (cgoBackend) ReferenceUnref(env Env, ref Reference) (int, Status) {
 	var result C.uint32_t
 	status := Status(C.napi_reference_unref(
 		C.napi_env(env),
@@ -59,31 +88,32 @@ func ReferenceUnref(env Env, ref Reference) (int, Status) {
 	return int(result), status
 }
 
-func GetReferenceValue(env Env, ref Reference) (Value, Status) {
+func (cgoBackend) GetReferenceValue(env Env, ref Reference) (Value, Status) {
 	var result Value
 	status := Status(C.napi_get_reference_value(
 		C.napi_env(env),
-		C.napi_ref(unsafe.Pointer(&ref)),
+		C.napi_ref(ref.Ref),
 		(*C.napi_value)(unsafe.Pointer(&result)),
 	))
 	return result, status
 }
 
-// Wrap function to use the Finalizer type internally
-func Wrap(env Env, jsObject Value, nativeObject unsafe.Pointer, finalize Finalize, finalizeHint unsafe.Pointer) Status {
+func (cgoBackend) Wrap(env Env, jsObject Value, nativeObject unsafe.Pointer, finalize Finalize, finalizeHint unsafe.Pointer) Status {
 	var result Reference
+	cFinalize, cHint := finalizeOf(finalize, finalizeHint)
 	status := Status(C.napi_wrap(
 		C.napi_env(env),
 		C.napi_value(jsObject),
 		nativeObject,
-		C.napi_finalize(unsafe.Pointer(&finalize)),
-		finalizeHint,
+		cFinalize,
+		cHint,
 		(*C.napi_ref)(unsafe.Pointer(&result)),
 	))
+	deleteFinalize(cFinalize, cHint, status)
 	return status
 }
 
-func Unwrap(env Env, jsObject Value) (unsafe.Pointer, Status) {
+func (cgoBackend) Unwrap(env Env, jsObject Value) (unsafe.Pointer, Status) {
 	var nativeObject unsafe.Pointer
 	status := Status(C.napi_unwrap(
 		C.napi_env(env),
@@ -93,7 +123,7 @@ func Unwrap(env Env, jsObject Value) (unsafe.Pointer, Status) {
 	return nativeObject, status
 }
 
-func RemoveWrap(env Env, jsObject Value) Status {
+func (cgoBackend) RemoveWrap(env Env, jsObject Value) Status {
 	var result unsafe.Pointer
 	return Status(C.napi_remove_wrap(
 		C.napi_env(env),
@@ -102,15 +132,7 @@ func RemoveWrap(env Env, jsObject Value) Status {
 	))
 }
 
-type EscapableHandleScope struct {
-	Scope unsafe.Pointer
-}
-
-type HandleScope struct {
-	Scope unsafe.Pointer
-}
-
-func OpenHandleScope(env Env) (HandleScope, Status) {
+func (cgoBackend) OpenHandleScope(env Env) (HandleScope, Status) {
 	var scope HandleScope
 	status := Status(C.napi_open_handle_scope(
 		C.napi_env(env),
@@ -119,14 +141,14 @@ func OpenHandleScope(env Env) (HandleScope, Status) {
 	return scope, status
 }
 
-func CloseHandleScope(env Env, scope HandleScope) Status {
+func (cgoBackend) CloseHandleScope(env Env, scope HandleScope) Status {
 	return Status(C.napi_close_handle_scope(
 		C.napi_env(env),
 		C.napi_handle_scope(unsafe.Pointer(&scope)),
 	))
 }
 
-func OpenEscapableHandleScope(env Env) (EscapableHandleScope, Status) {
+func (cgoBackend) OpenEscapableHandleScope(env Env) (EscapableHandleScope, Status) {
 	var scope EscapableHandleScope
 	status := Status(C.napi_open_escapable_handle_scope(
 		C.napi_env(env),
@@ -135,14 +157,14 @@ func OpenEscapableHandleScope(env Env) (EscapableHandleScope, Status) {
 	return scope, status
 }
 
-func CloseEscapableHandleScope(env Env, scope EscapableHandleScope) Status {
+func (cgoBackend) CloseEscapableHandleScope(env Env, scope EscapableHandleScope) Status {
 	return Status(C.napi_close_escapable_handle_scope(
 		C.napi_env(env),
 		C.napi_escapable_handle_scope(unsafe.Pointer(&scope)),
 	))
 }
 
-func EscapeHandle(env Env, scope EscapableHandleScope, escapee Value) (Value, Status) {
+func (cgoBackend) EscapeHandle(env Env, scope EscapableHandleScope, escapee Value) (Value, Status) {
 	var result Value
 	status := Status(C.napi_escape_handle(
 		C.napi_env(env),
@@ -1,25 +1,22 @@
 package napi
 
-// #include <node/node_api.h>
-import "C"
-
 // Describes the type of a napi_value. This generally corresponds to the types described in Section 6.1 of the ECMAScript Language Specification.
 // In addition to types in that section, napi_valuetype can also represent Functions and Objects with external data.
 //
 // A JavaScript value of type napi_external appears in JavaScript as a plain object such that no properties can be set on it, and no prototype.
-type ValueType C.napi_valuetype
+type ValueType uint32
 
 const (
-	ValueTypeUndefined ValueType = C.napi_undefined
-	ValueTypeNull      ValueType = C.napi_null
-	ValueTypeBoolean   ValueType = C.napi_boolean
-	ValueTypeNumber    ValueType = C.napi_number
-	ValueTypeString    ValueType = C.napi_string
-	ValueTypeSymbol    ValueType = C.napi_symbol
-	ValueTypeObject    ValueType = C.napi_object
-	ValueTypeFunction  ValueType = C.napi_function
-	ValueTypeExternal  ValueType = C.napi_external
-	ValueTypeBigint    ValueType = C.napi_bigint
+	ValueTypeUndefined ValueType = iota // napi_undefined
+	ValueTypeNull                       // napi_null
+	ValueTypeBoolean                    // napi_boolean
+	ValueTypeNumber                     // napi_number
+	ValueTypeString                     // napi_string
+	ValueTypeSymbol                     // napi_symbol
+	ValueTypeObject                     // napi_object
+	ValueTypeFunction                   // napi_function
+	ValueTypeExternal                   // napi_external
+	ValueTypeBigint                     // napi_bigint
 )
 
 func (v ValueType) String() string {
@@ -51,20 +48,20 @@ func (v ValueType) String() string {
 
 // This represents the underlying binary scalar datatype of the TypedArray.
 // Elements of this enum correspond to Section 22.2 of the ECMAScript Language Specification.
-type TypedArrayType C.napi_typedarray_type
+type TypedArrayType uint32
 
 const (
-	TypedArrayInt8Array         TypedArrayType = C.napi_int8_array
-	TypedArrayUint8Array        TypedArrayType = C.napi_uint8_array
-	TypedArrayUint8ClampedArray TypedArrayType = C.napi_uint8_clamped_array
-	TypedArrayInt16Array        TypedArrayType = C.napi_int16_array
-	TypedArrayUint16Array       TypedArrayType = C.napi_uint16_array
-	TypedArrayInt32Array        TypedArrayType = C.napi_int32_array
-	TypedArrayUint32Array       TypedArrayType = C.napi_uint32_array
-	TypedArrayFloat32Array      TypedArrayType = C.napi_float32_array
-	TypedArrayFloat64Array      TypedArrayType = C.napi_float64_array
-	TypedArrayBigInt64Array     TypedArrayType = C.napi_bigint64_array
-	TypedArrayBigUint64Array    TypedArrayType = C.napi_biguint64_array
+	TypedArrayInt8Array         TypedArrayType = iota // napi_int8_array
+	TypedArrayUint8Array                              // napi_uint8_array
+	TypedArrayUint8ClampedArray                       // napi_uint8_clamped_array
+	TypedArrayInt16Array                              // napi_int16_array
+	TypedArrayUint16Array                             // napi_uint16_array
+	TypedArrayInt32Array                              // napi_int32_array
+	TypedArrayUint32Array                             // napi_uint32_array
+	TypedArrayFloat32Array                            // napi_float32_array
+	TypedArrayFloat64Array                            // napi_float64_array
+	TypedArrayBigInt64Array                           // napi_bigint64_array
+	TypedArrayBigUint64Array                          // napi_biguint64_array
 )
 
 func (vTyped TypedArrayType) String() string {
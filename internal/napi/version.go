@@ -0,0 +1,6 @@
+//go:build !napi8 && !napi9 && !napi10
+
+package napi
+
+// Node-API version of headers used to build, NAPI_VERSION_EXPERIMENTAL without napi8, napi9 or napi10 tags
+const BuildVersion = 2147483647
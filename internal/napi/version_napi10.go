@@ -0,0 +1,6 @@
+//go:build napi10
+
+package napi
+
+// Node-API version of headers used to build, selected by napi10 tag
+const BuildVersion = 10
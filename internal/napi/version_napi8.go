@@ -0,0 +1,6 @@
+//go:build napi8
+
+package napi
+
+// Node-API version of headers used to build, selected by napi8 tag
+const BuildVersion = 8
@@ -0,0 +1,6 @@
+//go:build napi9
+
+package napi
+
+// Node-API version of headers used to build, selected by napi9 tag
+const BuildVersion = 9
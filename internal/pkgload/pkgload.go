@@ -0,0 +1,137 @@
+// Type check Go packages from source with export data of dependencies from go list,
+// used by napi-go dts and bind generators.
+package pkgload
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// Package type checked
+type Package struct {
+	ImportPath string
+	Name       string
+	Dir        string
+	Fset       *token.FileSet
+	Files      []*ast.File
+	Types      *types.Package
+	Info       *types.Info
+	Errors     []error // Type errors, only with allowErrors
+}
+
+// Package info from go list
+type listPackage struct {
+	ImportPath string
+	Name       string
+	Dir        string
+	GoFiles    []string
+	CgoFiles   []string
+	Export     string
+	ImportMap  map[string]string
+	DepOnly    bool
+	Error      *struct{ Err string }
+}
+
+// Load type check package (import path or directory relative to dir).
+//
+// With allowErrors type errors don't return error, used to generators that
+// run before package compile, example reference to generated functions.
+func Load(dir, pattern string, allowErrors bool) (*Package, error) {
+	pkgs, err := goList(dir, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *listPackage
+	exports := map[string]string{}
+	for _, pkg := range pkgs {
+		if !pkg.DepOnly {
+			if target != nil {
+				return nil, fmt.Errorf("pattern %q match more than one package", pattern)
+			}
+			target = pkg
+		} else if pkg.Error != nil {
+			return nil, fmt.Errorf("%s: %s", pkg.ImportPath, pkg.Error.Err)
+		}
+		exports[pkg.ImportPath] = pkg.Export
+	}
+	if target == nil {
+		return nil, fmt.Errorf("no package to %q", pattern)
+	} else if target.Error != nil && !allowErrors {
+		return nil, fmt.Errorf("%s: %s", target.ImportPath, target.Error.Err)
+	}
+
+	pkg := &Package{
+		ImportPath: target.ImportPath,
+		Name:       target.Name,
+		Dir:        target.Dir,
+		Fset:       token.NewFileSet(),
+		Info: &types.Info{
+			Types: map[ast.Expr]types.TypeAndValue{},
+			Defs:  map[*ast.Ident]types.Object{},
+			Uses:  map[*ast.Ident]types.Object{},
+		},
+	}
+
+	for _, name := range append(target.GoFiles, target.CgoFiles...) {
+		file, err := parser.ParseFile(pkg.Fset, filepath.Join(target.Dir, name), nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		pkg.Files = append(pkg.Files, file)
+	}
+
+	config := types.Config{
+		FakeImportC: true,
+		Importer: importer.ForCompiler(pkg.Fset, "gc", func(path string) (io.ReadCloser, error) {
+			if mapped, ok := target.ImportMap[path]; ok {
+				path = mapped
+			}
+			if exports[path] == "" {
+				return nil, fmt.Errorf("no export data to %q", path)
+			}
+			return os.Open(exports[path])
+		}),
+	}
+	if allowErrors {
+		config.Error = func(err error) { pkg.Errors = append(pkg.Errors, err) }
+	}
+	if pkg.Types, err = config.Check(target.ImportPath, pkg.Fset, pkg.Files, pkg.Info); err != nil && !allowErrors {
+		return nil, err
+	}
+	return pkg, nil
+}
+
+// Run go list to get package and export data of dependencies
+func goList(dir, pattern string) ([]*listPackage, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "list", "-e", "-json=ImportPath,Name,Dir,GoFiles,CgoFiles,Export,ImportMap,DepOnly,Error", "-export", "-deps", pattern)
+	cmd.Dir, cmd.Stdout, cmd.Stderr = dir, &stdout, &stderr
+	cmd.Env = append(os.Environ(), "CGO_ENABLED=1")
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("go list %s: %s", pattern, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	var pkgs []*listPackage
+	for decoder := json.NewDecoder(&stdout); ; {
+		pkg := new(listPackage)
+		if err := decoder.Decode(pkg); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	return pkgs, nil
+}
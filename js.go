@@ -22,6 +22,10 @@ func ValueOf(env EnvType, value any) (napiValue ValueType, err error) {
 // ValueFrom converts a N-API value (napiValue) to a Go value and stores the result in v.
 // The v parameter must be a pointer to the target Go variable where the converted value will be stored.
 // Returns an error if v is not a pointer or if the conversion fails.
+//
+// Javascript functions are decoded to Go functions calling Javascript function, safe to call from any goroutine.
+// Exception thrown or results that cannot be decoded are returned in last error result, if Go function type
+// not has error result the Go function panic with error, declare error result to handle exceptions.
 func ValueFrom(napiValue ValueType, v any) error {
 	ptr := reflect.ValueOf(v)
 	if ptr.Kind() != reflect.Pointer {
@@ -30,6 +34,19 @@ func ValueFrom(napiValue ValueType, v any) error {
 	return valueFrom(napiValue, ptr.Elem())
 }
 
+// ValueFromCoerce is like [ValueFrom] but apply Javascript coercion semantics to primitive Go types,
+// example "42" decode in int field, numbers decode in string field and any value decode in bool field.
+// undefined and null are decoded to zero value of Go type.
+//
+// Coercion use [CoerceString], [CoerceNumber] and [CoerceBoolean].
+func ValueFromCoerce(napiValue ValueType, v any) error {
+	ptr := reflect.ValueOf(v)
+	if ptr.Kind() != reflect.Pointer {
+		return fmt.Errorf("require point to convert napi value to go value")
+	}
+	return decodeValue(napiValue, ptr.Elem(), true)
+}
+
 // Convert go types to valid NAPI, if not conpatible return Undefined.
 func valueOf(env EnvType, ptr reflect.Value) (napiValue ValueType, err error) {
 	defer func(err *error) {
@@ -43,15 +60,22 @@ func valueOf(env EnvType, ptr reflect.Value) (napiValue ValueType, err error) {
 		}
 	}(&err)
 
+	if !ptr.IsValid() {
+		return env.Undefined()
+	}
+
 	ptrType := ptr.Type()
 	if ptrType.ConvertibleTo(reflect.TypeFor[ValueType]()) {
-		if ptr.IsValid() {
-			return ptr.Interface().(ValueType), nil
+		if value, _ := ptr.Interface().(ValueType); value != nil && !(ptr.Kind() == reflect.Pointer && ptr.IsNil()) {
+			return value, nil
 		}
-		return nil, nil
-	} else if !ptr.IsValid() {
 		return env.Undefined()
-	} else if !ptr.IsZero() && ptr.CanInterface() { // Marshalers
+	} else if ptr.CanInterface() && !(ptr.Kind() == reflect.Pointer && ptr.IsNil()) {
+		if marshaler, ok := ptr.Interface().(Marshaler); ok {
+			return marshaler.MarshalNapi(env)
+		}
+	}
+	if !ptr.IsZero() && ptr.CanInterface() { // Marshalers
 		switch v := ptr.Interface().(type) {
 		case time.Time:
 			return CreateDate(env, v)
@@ -75,20 +99,30 @@ func valueOf(env EnvType, ptr reflect.Value) (napiValue ValueType, err error) {
 
 	switch ptrType.Kind() {
 	case reflect.Pointer:
+		if ptr.IsNil() {
+			return env.Undefined()
+		}
 		return valueOf(env, ptr.Elem())
 	case reflect.String:
 		return CreateString(env, ptr.String())
 	case reflect.Bool:
 		return CreateBoolean(env, ptr.Bool())
-	case reflect.Int, reflect.Uint, reflect.Int32, reflect.Uint32, reflect.Float32, reflect.Int8, reflect.Uint8, reflect.Int16, reflect.Uint16:
+	case reflect.Int, reflect.Int32, reflect.Int8, reflect.Int16:
 		return CreateNumber(env, ptr.Int())
-	case reflect.Float64:
+	case reflect.Uint, reflect.Uint32, reflect.Uint8, reflect.Uint16:
+		return CreateNumber(env, ptr.Uint())
+	case reflect.Float32, reflect.Float64:
 		return CreateNumber(env, ptr.Float())
-	case reflect.Int64, reflect.Uint64:
+	case reflect.Int64:
 		return CreateBigint(env, ptr.Int())
+	case reflect.Uint64:
+		return CreateBigint(env, ptr.Uint())
 	case reflect.Func:
 		return funcOf(env, ptr)
 	case reflect.Slice, reflect.Array:
+		if ptrType.Kind() == reflect.Slice && ptrType.Elem().Kind() == reflect.Uint8 { // []byte to Buffer
+			return CopyBuffer(env, ptr.Bytes())
+		}
 		arr, err := CreateArray(env, ptr.Len())
 		if err != nil {
 			return nil, err
@@ -218,8 +252,43 @@ func valueOf(env EnvType, ptr reflect.Value) (napiValue ValueType, err error) {
 	return env.Undefined()
 }
 
+// Apply javascript coercion to decode jsValue in primitive go kind (string, bool, int*, uint*, float*),
+// undefined and null return as is to decode to zero value.
+func coerceValue(jsValue ValueType, typeOf NapiType, kind reflect.Kind) (ValueType, NapiType, error) {
+	switch typeOf {
+	case TypeUndefined, TypeNull, TypeSymbol:
+		return jsValue, typeOf, nil
+	}
+
+	switch kind {
+	case reflect.String:
+		if typeOf != TypeString {
+			str, err := CoerceString(jsValue)
+			return str, TypeString, err
+		}
+	case reflect.Bool:
+		if typeOf != TypeBoolean {
+			b, err := CoerceBoolean(jsValue)
+			return b, TypeBoolean, err
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if typeOf != TypeNumber && typeOf != TypeBigInt {
+			num, err := CoerceNumber(jsValue)
+			return num, TypeNumber, err
+		}
+	}
+	return jsValue, typeOf, nil
+}
+
 // Convert javascript value to go typed value
 func valueFrom(jsValue ValueType, ptr reflect.Value) error {
+	return decodeValue(jsValue, ptr, false)
+}
+
+// Convert javascript value to go typed value, if coerce is true apply javascript coercion to primitive go types
+func decodeValue(jsValue ValueType, ptr reflect.Value, coerce bool) error {
 	typeOf, err := jsValue.Type()
 	if err != nil {
 		return err
@@ -276,9 +345,33 @@ func valueFrom(jsValue ValueType, ptr reflect.Value) error {
 		return nil
 	}
 
+	if ptr.CanAddr() && ptr.Addr().CanInterface() {
+		if unmarshaler, ok := ptr.Addr().Interface().(Unmarshaler); ok {
+			return unmarshaler.UnmarshalNapi(jsValue)
+		}
+	}
+
+	if coerce {
+		if jsValue, typeOf, err = coerceValue(jsValue, typeOf, ptrType.Kind()); err != nil {
+			return err
+		} else if (typeOf == TypeUndefined || typeOf == TypeNull) && ptrType.Kind() != reflect.Pointer && ptrType.Kind() != reflect.Interface {
+			ptr.Set(reflect.Zero(ptrType)) // undefined and null decode to zero value
+			return nil
+		}
+	}
+
 	switch ptrType.Kind() {
 	case reflect.Pointer:
-		return valueFrom(jsValue, ptr.Elem())
+		if (typeOf == TypeUndefined || typeOf == TypeNull) && ptr.CanSet() { // nil pointer is undefined in ValueOf
+			ptr.Set(reflect.Zero(ptrType))
+			return nil
+		} else if ptr.IsNil() {
+			if !ptr.CanSet() {
+				break
+			}
+			ptr.Set(reflect.New(ptrType.Elem()))
+		}
+		return decodeValue(jsValue, ptr.Elem(), coerce)
 	case reflect.Interface:
 		if !ptr.CanSet() || ptrType != reflect.TypeFor[any]() {
 			break
@@ -329,7 +422,7 @@ func valueFrom(jsValue ValueType, ptr reflect.Value) error {
 				napiValue, err := napiArray.Get(index)
 				if err != nil {
 					return err
-				} else if err = valueFrom(napiValue, value.Index(index)); err != nil {
+				} else if err = decodeValue(napiValue, value.Index(index), coerce); err != nil {
 					return err
 				}
 			}
@@ -345,7 +438,7 @@ func valueFrom(jsValue ValueType, ptr reflect.Value) error {
 			goMap := reflect.MakeMap(reflect.MapOf(reflect.TypeFor[string](), reflect.TypeFor[any]()))
 			for keyName, value := range obj.Seq() {
 				valueOf := reflect.New(reflect.TypeFor[any]())
-				if err := valueFrom(value, valueOf); err != nil {
+				if err := decodeValue(value, valueOf, coerce); err != nil {
 					return err
 				}
 				goMap.SetMapIndex(reflect.ValueOf(keyName), valueOf)
@@ -363,7 +456,7 @@ func valueFrom(jsValue ValueType, ptr reflect.Value) error {
 		if err != nil {
 			return err
 		}
-		ptr.Set(reflect.ValueOf(valueOf))
+		ptr.SetString(valueOf)
 		return nil
 	case reflect.Bool:
 		if typeOf != TypeBoolean {
@@ -373,7 +466,7 @@ func valueFrom(jsValue ValueType, ptr reflect.Value) error {
 		if err != nil {
 			return err
 		}
-		ptr.Set(reflect.ValueOf(b))
+		ptr.SetBool(b)
 		return nil
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		switch typeOf {
@@ -419,10 +512,22 @@ func valueFrom(jsValue ValueType, ptr reflect.Value) error {
 		}
 		ptr.SetFloat(f)
 		return nil
-	case reflect.Func, reflect.Chan:
+	case reflect.Func:
+		if typeOf == TypeFunction && ptr.CanSet() {
+			return decodeFunc(ToFunction(jsValue), ptr)
+		}
+		return nil
+	case reflect.Chan:
 		return nil
 	case reflect.Slice:
-		if typeOf != TypeArray {
+		if (typeOf == TypeBuffer || typeOf == TypeTypedArray) && ptrType.Elem().Kind() == reflect.Uint8 { // Buffer or Uint8Array to []byte
+			data, err := ToBuffer(jsValue).Data()
+			if err != nil {
+				return err
+			}
+			ptr.SetBytes(append(reflect.MakeSlice(ptrType, 0, len(data)).Bytes(), data...))
+			return nil
+		} else if typeOf != TypeArray {
 			break
 		}
 		jsArr := ToArray(jsValue)
@@ -435,10 +540,11 @@ func valueFrom(jsValue ValueType, ptr reflect.Value) error {
 			jsValue, err := jsArr.Get(index)
 			if err != nil {
 				return err
-			} else if err = valueFrom(jsValue, ptr.Index(index)); err != nil {
+			} else if err = decodeValue(jsValue, ptr.Index(index), coerce); err != nil {
 				return err
 			}
 		}
+		return nil
 	case reflect.Map:
 		// Check if key is string, bool, int*, uint*, float*, else return error
 		switch ptrType.Key().Kind() {
@@ -485,7 +591,7 @@ func valueFrom(jsValue ValueType, ptr reflect.Value) error {
 			}
 
 			valueOf := reflect.New(ptrType.Elem()).Elem()
-			if err := valueFrom(value, valueOf); err != nil {
+			if err := decodeValue(value, valueOf, coerce); err != nil {
 				return err
 			}
 			goMap.SetMapIndex(keySetValue, valueOf)
@@ -494,18 +600,31 @@ func valueFrom(jsValue ValueType, ptr reflect.Value) error {
 		return nil
 	case reflect.Struct:
 		switch typeOf {
+		case TypeDate:
+			if ptrType != reflect.TypeFor[time.Time]() {
+				break
+			}
+			timeDate, err := ToDate(jsValue).Time()
+			if err != nil {
+				return err
+			}
+			ptr.Set(reflect.ValueOf(timeDate))
+			return nil
 		case TypeString:
 			str, err := ToString(jsValue).Utf8Value()
 			if err != nil {
 				return err
 			}
-			switch {
-			case ptrType.ConvertibleTo(reflect.TypeFor[encoding.TextUnmarshaler]()):
-				return ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(str))
-			case ptrType.ConvertibleTo(reflect.TypeFor[json.Unmarshaler]()):
+			if !ptr.CanAddr() {
+				break
+			}
+			switch v := ptr.Addr().Interface().(type) {
+			case encoding.TextUnmarshaler:
+				return v.UnmarshalText([]byte(str))
+			case json.Unmarshaler:
 				var raw json.RawMessage
 				if err = json.Unmarshal([]byte(str), &raw); err == nil {
-					return ptr.Interface().(json.Unmarshaler).UnmarshalJSON(raw)
+					return v.UnmarshalJSON(raw)
 				}
 			}
 		case TypeObject:
@@ -534,7 +653,7 @@ func valueFrom(jsValue ValueType, ptr reflect.Value) error {
 
 				value, _ := obj.Get(keyName)
 				valueOf := reflect.New(fieldType.Type)
-				if err := valueFrom(value, valueOf); err != nil {
+				if err := decodeValue(value, valueOf, coerce); err != nil {
 					return err
 				}
 				ptr.Field(keyIndex).Set(valueOf.Elem())
@@ -1,6 +1,8 @@
 package napi
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"runtime"
@@ -9,7 +11,11 @@ import (
 	internalNapi "sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
 )
 
-var typeofError = reflect.TypeFor[error]()
+var (
+	typeofError    = reflect.TypeFor[error]()
+	typeofContext  = reflect.TypeFor[context.Context]()
+	typeofCallback = reflect.TypeFor[Callback]()
+)
 
 // GoFuncOf wraps a Go function as a JavaScript-compatible function for use with the given environment.
 // It takes an EnvType representing the JavaScript environment and a Go function (of any type).
@@ -19,6 +25,16 @@ func GoFuncOf(env EnvType, function any) (ValueType, error) {
 }
 
 func funcOf(env EnvType, ptr reflect.Value) (ValueType, error) {
+	return namedFuncOf(env, "", ptr)
+}
+
+// Same of funcOf with Javascript function name, if name is empty use Go function name
+func namedFuncOf(env EnvType, funcName string, ptr reflect.Value) (ValueType, error) {
+	return executorFuncOf(env, funcName, ptr, nil)
+}
+
+// Same of namedFuncOf, async Go functions run in executor, if nil in executor of env
+func executorFuncOf(env EnvType, funcName string, ptr reflect.Value, executor *Executor) (ValueType, error) {
 	if ptr.Kind() != reflect.Func {
 		return nil, fmt.Errorf("return function to return napi value")
 	} else if !ptr.IsValid() {
@@ -30,75 +46,120 @@ func funcOf(env EnvType, ptr reflect.Value) (ValueType, error) {
 		return nil, nil
 	}
 
-	funcName := strings.ReplaceAll(runtime.FuncForPC(ptr.Pointer()).Name(), ".", "_")
-	switch v := ptr.Interface().(type) {
-	case Callback: // return function value
-		return CreateFunction(env, funcName, v)
-	case internalNapi.Callback: // return internal/napi function value
+	if funcName == "" {
+		funcName = strings.ReplaceAll(runtime.FuncForPC(ptr.Pointer()).Name(), ".", "_")
+	}
+	if v, ok := ptr.Interface().(internalNapi.Callback); ok { // return internal/napi function value
 		return CreateFunctionNapi(env, funcName, v)
-	default: // Convert go function to javascript function
-		return CreateFunction(env, funcName, func(ci *CallbackInfo) (ValueType, error) {
-			var goFnReturn []reflect.Value
-			in, out, veridict := ptr.Type().NumIn(), ptr.Type().NumOut(), ptr.Type().IsVariadic()
-
-			// Check to call
-			switch {
-			case in == 0 && out == 0: // only call
-				goFnReturn = ptr.Call([]reflect.Value{})
-			case !veridict: // call same args
-				goFnReturn = ptr.Call(goValuesInFunc(ptr, ci.Args, false))
-			default: // call with slice on end
-				goFnReturn = ptr.CallSlice(goValuesInFunc(ptr, ci.Args, true))
-			}
+	}
+	fn, err := CreateFunction(env, funcName, goFuncCallback(ptr, executor))
+	if err != nil {
+		return nil, err
+	}
+	return fn, nil // length is 0, all arguments can be omitted same of Javascript default parameters
+}
 
-			// Check for last element is error
-			if len(goFnReturn) > 0 {
-				lastValue := goFnReturn[len(goFnReturn)-1]
-				if lastValue.CanConvert(typeofError) {
-					goFnReturn = goFnReturn[:len(goFnReturn)-1] // remove last element from return
-					if !lastValue.IsNil() {                     // check if not is nil to throw error in javascript
-						return nil, lastValue.Interface().(error)
-					}
-				}
-			}
+// Return count of Javascript arguments required by overload, parameters before variadic and trailing optional parameters
+func goFuncLength(fnType reflect.Type) int {
+	skip, length := goFuncSkip(fnType), fnType.NumIn()
+	if fnType.IsVariadic() {
+		length--
+	}
+	for length > skip && isOptionalParam(fnType.In(length-1)) {
+		length--
+	}
+	return length - skip
+}
 
-			// Check return value
-			switch len(goFnReturn) {
-			case 0: // not value to return
-				return env.Undefined()
-			case 1: // Check if error or value to return
-				return valueOf(env, goFnReturn[0])
-			}
+// Return count of first Go parameters not converted from Javascript arguments: context.Context, *CallbackInfo or This
+func goFuncSkip(fnType reflect.Type) int {
+	if fnType.NumIn() > 0 {
+		switch fnType.In(0) {
+		case typeofContext, typeofCallbackInfo, typeofThis:
+			return 1
+		}
+	}
+	return 0
+}
 
-			// Convert to array return and check if latest is error
-			napiValueReturn, err := CreateArray(env, len(goFnReturn))
-			if err != nil {
-				return nil, err
-			}
+// Return [Callback] to call Go function, [Callback] functions are returned without reflection
+func goFuncCallback(ptr reflect.Value, executor *Executor) Callback {
+	if v, ok := ptr.Interface().(Callback); ok {
+		return v
+	} else if ptr.Type().NumIn() > 0 && ptr.Type().In(0) == typeofContext {
+		return func(ci *CallbackInfo) (ValueType, error) { return callContextFunc(ci, ptr, executor) }
+	}
 
-			// Append values to js array
-			for index, value := range goFnReturn {
-				napiValue, err := valueOf(env, value)
-				if err != nil {
-					return nil, err
-				} else if err = napiValueReturn.Set(index, napiValue); err != nil {
-					return nil, err
-				}
-			}
-			return napiValueReturn, nil
-		})
+	skip := goFuncSkip(ptr.Type()) // *CallbackInfo or This as first parameter
+	return func(ci *CallbackInfo) (ValueType, error) {
+		var in []reflect.Value
+		switch {
+		case skip == 0:
+		case ptr.Type().In(0) == typeofCallbackInfo:
+			in = append(in, reflect.ValueOf(ci))
+		default:
+			in = append(in, reflect.ValueOf(This{ToObject(ci.This)}))
+		}
+
+		args, err := goValuesInFunc(ptr, ci.Args, ptr.Type().IsVariadic(), skip)
+		if err != nil {
+			return nil, err
+		}
+		in = append(in, args...)
+		if ptr.Type().IsVariadic() { // call with slice on end
+			return funcResults(ptr.CallSlice(in)).MarshalNapi(ci.Env)
+		}
+		return funcResults(ptr.Call(in)).MarshalNapi(ci.Env)
 	}
 }
 
-// Create call value to go function
-func goValuesInFunc(ptr reflect.Value, jsArgs []ValueType, variadic bool) (values []reflect.Value) {
-	if variadic && (ptr.Type().NumIn()-1 > 0) && ptr.Type().NumIn()-1 < len(jsArgs) {
-		panic(fmt.Errorf("require minimun %d arguments, called with %d", ptr.Type().NumIn()-1, len(jsArgs)))
-	} else if !variadic && ptr.Type().NumIn() != len(jsArgs) {
-		panic(fmt.Errorf("require %d arguments, called with %d", ptr.Type().NumIn(), len(jsArgs)))
+// Returns of Go function, converted to Javascript value with last error removed
+// and multiple values as array
+type funcResults []reflect.Value
+
+func (goFnReturn funcResults) MarshalNapi(env EnvType) (ValueType, error) {
+	// Check for last element is error
+	if len(goFnReturn) > 0 {
+		lastValue := goFnReturn[len(goFnReturn)-1]
+		if lastValue.CanConvert(typeofError) {
+			goFnReturn = goFnReturn[:len(goFnReturn)-1] // remove last element from return
+			if !lastValue.IsNil() {                     // check if not is nil to throw error in javascript
+				return nil, lastValue.Interface().(error)
+			}
+		}
+	}
+
+	// Check return value
+	switch len(goFnReturn) {
+	case 0: // not value to return
+		return env.Undefined()
+	case 1: // Check if error or value to return
+		return valueOf(env, goFnReturn[0])
+	}
+
+	// Convert to array return and check if latest is error
+	napiValueReturn, err := CreateArray(env, len(goFnReturn))
+	if err != nil {
+		return nil, err
 	}
 
-	size := ptr.Type().NumIn()
+	// Append values to js array
+	for index, value := range goFnReturn {
+		napiValue, err := valueOf(env, value)
+		if err != nil {
+			return nil, err
+		} else if err = napiValueReturn.Set(index, napiValue); err != nil {
+			return nil, err
+		}
+	}
+	return napiValueReturn, nil
+}
+
+// Create call value to go function, skip is count of first Go arguments not converted from Javascript.
+// Omitted trailing arguments are zero values of any type, undefined only to optional parameters (pointer, interface and [Optional]),
+// extra arguments are ignored.
+func goValuesInFunc(ptr reflect.Value, jsArgs []ValueType, variadic bool, skip int) (values []reflect.Value, err error) {
+	size := ptr.Type().NumIn() - skip
 	if variadic {
 		size-- // remove latest value to slice
 	}
@@ -106,33 +167,144 @@ func goValuesInFunc(ptr reflect.Value, jsArgs []ValueType, variadic bool) (value
 	// Convert value
 	values = make([]reflect.Value, size)
 	for index := range values {
-		// Create value to append go value
-		ptrType := ptr.Type().In(index)
-		switch ptrType.Kind() {
-		case reflect.Pointer:
-			values[index] = reflect.New(ptrType.Elem())
-		case reflect.Slice:
-			values[index] = reflect.MakeSlice(ptrType, 0, 0)
-		default:
-			values[index] = reflect.New(ptrType).Elem()
-		}
-		if err := valueFrom(jsArgs[index], values[index]); err != nil {
-			panic(err)
+		if index >= len(jsArgs) {
+			values[index] = reflect.Zero(ptr.Type().In(skip + index))
+			continue
+		} else if values[index], err = decodeArgument(jsArgs, index, ptr.Type().In(skip+index)); err != nil {
+			return nil, err
 		}
 	}
 
 	if variadic {
-		variadicType := ptr.Type().In(size).Elem()
-
-		valueAppend := jsArgs[size:]
-		valueOf := reflect.MakeSlice(reflect.SliceOf(variadicType), len(valueAppend), len(valueAppend))
-		for index := range valueAppend {
-			if err := valueFrom(valueAppend[index], valueOf.Index(index)); err != nil {
-				panic(err)
+		variadicType := ptr.Type().In(skip + size).Elem()
+		var count int
+		if len(jsArgs) > size {
+			count = len(jsArgs) - size
+		}
+		valueOf := reflect.MakeSlice(reflect.SliceOf(variadicType), count, count)
+		for index := range count {
+			value, err := decodeArgument(jsArgs, size+index, variadicType)
+			if err != nil {
+				return nil, err
 			}
+			valueOf.Index(index).Set(value)
 		}
 		values = append(values, valueOf)
 	}
+	return values, nil
+}
+
+// Set ptr with Go function calling Javascript function, arguments are converted with [ValueOf] and
+// return value decoded to Go results, if function return array and Go function has more results each
+// result is decoded from array index. Exception thrown is returned in last error result, or panic with error
+// if fnType not has error result.
+//
+// Go function can be called from Javascript thread or any goroutine, in goroutines wait Javascript thread with [RunOnJS].
+func decodeFunc(jsFunc *Function, ptr reflect.Value) error {
+	env, fnType := jsFunc.Env(), ptr.Type()
+	ref, status := internalNapi.CreateReference(env.NapiValue(), jsFunc.NapiValue(), 1)
+	if err := statusError(env.NapiValue(), "napi_create_reference", status); err != nil {
+		return err
+	}
 
-	return
+	holder := &jsFuncRef{ref}
+	ptr.Set(reflect.MakeFunc(fnType, func(args []reflect.Value) []reflect.Value {
+		if isJSThread(env) {
+			results, err := holder.call(env, fnType, args)
+			return jsFuncResults(fnType, results, err)
+		}
+		results, err := RunOnJS(env, func(env EnvType) ([]reflect.Value, error) {
+			results, err := holder.call(env, fnType, args)
+			if exception, ok := err.(*Exception); ok {
+				err = errors.New(exception.Error()) // Javascript value is valid only in Javascript thread
+			}
+			return results, err
+		})
+		return jsFuncResults(fnType, results, err)
+	}))
+
+	// Delete reference when Go function is collected
+	runtime.AddCleanup(holder, func(ref internalNapi.Reference) {
+		Post(env, func(env EnvType) { internalNapi.DeleteReference(env.NapiValue(), ref) })
+	}, ref)
+	return nil
+}
+
+// Reference to Javascript function of decoded Go function
+type jsFuncRef struct{ ref internalNapi.Reference }
+
+// Call Javascript function with Go arguments and decode results of fnType, in Javascript thread
+func (holder *jsFuncRef) call(env EnvType, fnType reflect.Type, args []reflect.Value) ([]reflect.Value, error) {
+	napiValue, status := internalNapi.GetReferenceValue(env.NapiValue(), holder.ref)
+	if err := statusError(env.NapiValue(), "napi_get_reference_value", status); err != nil {
+		return nil, err
+	}
+
+	if fnType.IsVariadic() && len(args) > 0 { // Spread variadic slice
+		last := args[len(args)-1]
+		args = args[: len(args)-1 : len(args)-1]
+		for index := range last.Len() {
+			args = append(args, last.Index(index))
+		}
+	}
+	jsArgs := make([]ValueType, len(args))
+	for index, arg := range args {
+		jsArg, err := valueOf(env, arg)
+		if err != nil {
+			return nil, err
+		} else if jsArg == nil {
+			if jsArg, err = env.Undefined(); err != nil {
+				return nil, err
+			}
+		}
+		jsArgs[index] = jsArg
+	}
+
+	result, err := ToFunction(N_APIValue(env, napiValue)).Call(jsArgs...)
+	if errors.Is(err, ErrPendingException) {
+		return nil, lastException(env)
+	} else if err != nil {
+		return nil, err
+	}
+
+	numOut := fnType.NumOut()
+	if numOut > 0 && fnType.Out(numOut-1) == typeofError {
+		numOut--
+	}
+	results := make([]reflect.Value, numOut)
+	for index := range results {
+		results[index] = reflect.New(fnType.Out(index)).Elem()
+		value := result
+		if numOut > 1 {
+			if value, err = ToArray(result).Get(index); err != nil {
+				return nil, err
+			}
+		}
+		if err = valueFrom(value, results[index]); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// Return results of Go function with error as last result, if Go function not return error panic with err
+func jsFuncResults(fnType reflect.Type, results []reflect.Value, err error) []reflect.Value {
+	hasError := fnType.NumOut() > 0 && fnType.Out(fnType.NumOut()-1) == typeofError
+	if err != nil {
+		if !hasError {
+			panic(err)
+		}
+		results = make([]reflect.Value, fnType.NumOut()-1)
+		for index := range results {
+			results[index] = reflect.Zero(fnType.Out(index))
+		}
+	}
+	if hasError {
+		errValue := reflect.Zero(typeofError)
+		if err != nil {
+			errValue = reflect.ValueOf(&err).Elem()
+		}
+		results = append(results, errValue)
+	}
+	return results
 }
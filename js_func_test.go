@@ -0,0 +1,117 @@
+package napi_test
+
+import (
+	"errors"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+// Decode Javascript function source to Go function pointed by fn
+func decodeFunc(t *napitest.T, env napi.EnvType, source string, fn any) {
+	t.Helper()
+	if err := napi.ValueFrom(script(t, env, source), fn); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Call fn and return value of panic
+func recoverPanic(fn func()) (value any) {
+	defer func() { value = recover() }()
+	fn()
+	return nil
+}
+
+func TestDecodeFunc(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		var add func(a, b int) int
+		decodeFunc(t, env, `(a, b) => a + b`, &add)
+		if got := add(1, 2); got != 3 {
+			t.Errorf("add(1, 2) = %d, want 3", got)
+		}
+
+		var divide func(a, b int) (int, int, error)
+		decodeFunc(t, env, `(a, b) => [Math.floor(a / b), a % b]`, &divide)
+		if quotient, remainder, err := divide(7, 2); err != nil || quotient != 3 || remainder != 1 {
+			t.Errorf("divide(7, 2) = %d, %d, %v, want 3, 1, nil", quotient, remainder, err)
+		}
+
+		var join func(sep string, values ...int) string
+		decodeFunc(t, env, `(sep, ...values) => values.join(sep) + "/" + values.length`, &join)
+		if got := join("-", 1, 2, 3); got != "1-2-3/3" {
+			t.Errorf("join(\"-\", 1, 2, 3) = %q, want %q", got, "1-2-3/3")
+		} else if got = join("-"); got != "/0" {
+			t.Errorf("join(\"-\") = %q, want %q", got, "/0")
+		}
+
+		var point func(x, y int) (struct{ X, Y int }, error)
+		decodeFunc(t, env, `(x, y) => ({X: x, Y: y})`, &point)
+		if got, err := point(1, 2); err != nil || got.X != 1 || got.Y != 2 {
+			t.Errorf("point(1, 2) = %+v, %v", got, err)
+		}
+	})
+}
+
+func TestDecodeFuncError(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		var fail func(string) (int, error)
+		decodeFunc(t, env, `(message) => { throw new TypeError(message) }`, &fail)
+		n, err := fail("bad argument")
+		var exception *napi.Exception
+		if !errors.As(err, &exception) || exception.Name != "TypeError" || exception.Message != "bad argument" || n != 0 {
+			t.Errorf("fail() = %d, %v, want TypeError exception", n, err)
+		}
+
+		var results func() (int, string, error)
+		decodeFunc(t, env, `() => 1`, &results)
+		if _, _, err := results(); err == nil {
+			t.Error("results not array not return error")
+		}
+
+		var invalid func() (int, error)
+		decodeFunc(t, env, `() => "text"`, &invalid)
+		if _, err := invalid(); err == nil {
+			t.Error("result with wrong type not return error")
+		}
+
+		// Without error result panic with error
+		var noError func()
+		decodeFunc(t, env, `() => { throw new Error("boom") }`, &noError)
+		value := recoverPanic(noError)
+		if err, ok := value.(error); !ok || !errors.As(err, &exception) || exception.Message != "boom" {
+			t.Errorf("panic %v, want exception boom", value)
+		}
+	})
+}
+
+func TestDecodeFuncGoroutine(t *testing.T) {
+	var add func(a, b int) (int, error)
+	var sum func(values ...int) int
+	var fail func() error
+	var noError func()
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		decodeFunc(t, env, `(a, b) => a + b`, &add)
+		decodeFunc(t, env, `(...values) => values.reduce((a, b) => a + b, 0)`, &sum)
+		decodeFunc(t, env, `() => { throw new RangeError("out of range") }`, &fail)
+		decodeFunc(t, env, `() => { throw new Error("boom") }`, &noError)
+	})
+
+	// Called outside Javascript thread wait Javascript thread with RunOnJS
+	if got, err := add(2, 3); err != nil || got != 5 {
+		t.Errorf("add(2, 3) = %d, %v, want 5", got, err)
+	}
+	if got := sum(1, 2, 3, 4); got != 10 {
+		t.Errorf("sum(1, 2, 3, 4) = %d, want 10", got)
+	}
+
+	// Exception is converted to Go error, Javascript value is valid only in Javascript thread
+	err := fail()
+	var exception *napi.Exception
+	if err == nil || err.Error() != "RangeError: out of range" || errors.As(err, &exception) {
+		t.Errorf("fail() = %#v, want error RangeError: out of range", err)
+	}
+	if value := recoverPanic(noError); value == nil || value.(error).Error() != "Error: boom" {
+		t.Errorf("panic %v, want error Error: boom", value)
+	}
+}
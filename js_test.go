@@ -0,0 +1,197 @@
+package napi_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+type user struct {
+	Name  string `napi:"name"`
+	Age   int    `napi:"age,omitempty"`
+	Admin bool   `napi:"admin"`
+	Tags  []string
+	skip  int
+}
+
+func TestValueOf(t *testing.T) {
+	date := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{"string", "gopher", `"gopher"`},
+		{"int", 42, `42`},
+		{"float", 1.5, `1.5`},
+		{"int64", int64(1) << 40, `"1099511627776n"`},
+		{"bool", true, `true`},
+		{"nil", nil, `"undefined"`},
+		{"nil pointer", (*int)(nil), `"undefined"`},
+		{"pointer", &[]int{1}, `[1]`},
+		{"slice", []int{1, 2, 3}, `[1,2,3]`},
+		{"array", [2]string{"a", "b"}, `["a","b"]`},
+		{"bytes", []byte{0xca, 0xfe}, `Buffer<cafe>`},
+		{"map", map[string]int{"a": 1}, `{"a":1}`},
+		{"struct", user{Name: "Ana", Tags: []string{"go"}}, `{"name":"Ana","age":0,"admin":false,"Tags":["go"]}`},
+		{"time", date, `Date<2025-01-02T03:04:05.000Z>`},
+		{"any", []any{"a", 1, nil}, `["a",1,null]`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				value, err := napi.ValueOf(env, test.value)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value == nil {
+					if value, err = env.Undefined(); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if got := describe(t, value); got != test.want {
+					t.Errorf("ValueOf(%#v) = %s, want %s", test.value, got, test.want)
+				}
+			})
+		})
+	}
+}
+
+func TestValueFrom(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   any
+	}{
+		{"string", `"gopher"`, "gopher"},
+		{"int", `42`, 42},
+		{"float", `1.25`, 1.25},
+		{"bigint", `2n ** 40n`, int64(1) << 40},
+		{"bool", `true`, true},
+		{"slice", `[1, 2, 3]`, []int{1, 2, 3}},
+		{"bytes", `Buffer.from("cafe", "hex")`, []byte{0xca, 0xfe}},
+		{"map", `({a: 1, b: 2})`, map[string]int{"a": 1, "b": 2}},
+		{"struct", `({name: "Ana", age: 30, admin: true, Tags: ["go"]})`, user{Name: "Ana", Age: 30, Admin: true, Tags: []string{"go"}}},
+		{"pointer", `({name: "Bia"})`, &user{Name: "Bia"}},
+		{"time", `new Date(Date.UTC(2025, 0, 2))`, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"any", `["a", 1, true]`, []any{"a", float64(1), true}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				got := reflect.New(reflect.TypeOf(test.want))
+				if err := napi.ValueFrom(script(t, env, test.source), got.Interface()); err != nil {
+					t.Fatal(err)
+				}
+				if want, ok := test.want.(time.Time); ok {
+					if !got.Elem().Interface().(time.Time).Equal(want) {
+						t.Errorf("ValueFrom(%s) = %s, want %s", test.source, got.Elem().Interface(), want)
+					}
+				} else if !reflect.DeepEqual(got.Elem().Interface(), test.want) {
+					t.Errorf("ValueFrom(%s) = %#v, want %#v", test.source, got.Elem().Interface(), test.want)
+				}
+			})
+		})
+	}
+}
+
+func TestValueFromError(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		var n int
+		if err := napi.ValueFrom(script(t, env, `"not a number"`), &n); err == nil {
+			t.Errorf("ValueFrom(string, *int) = %d, want error", n)
+		}
+	})
+}
+
+func TestValueRoundTrip(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		want := map[string][]user{"admins": {{Name: "Ana", Admin: true, Tags: []string{}}}}
+		value, err := napi.ValueOf(env, want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got map[string][]user
+		if err = napi.ValueFrom(value, &got); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %#v, want %#v", got, want)
+		}
+	})
+}
+
+// Breaking changes of CHANGELOG.md: []byte is Buffer and nil pointer is undefined
+func TestValueOfBytesAndNilPointer(t *testing.T) {
+	type blob []byte
+	type document struct {
+		Data   []byte `napi:"data"`
+		Parent *user  `napi:"parent"`
+	}
+	tests := []struct {
+		name  string
+		value any
+		check string // Javascript function to check value, return true
+	}{
+		{"bytes", []byte{1, 2}, `v => Buffer.isBuffer(v) && !Array.isArray(v) && v.equals(Buffer.from([1, 2]))`},
+		{"empty bytes", []byte{}, `v => Buffer.isBuffer(v) && v.length === 0`},
+		{"named bytes", blob{3}, `v => Buffer.isBuffer(v) && v[0] === 3`},
+		{"byte array", [2]byte{1, 2}, `v => Array.isArray(v) && v.length === 2`},
+		{"int slice", []int{1, 2}, `v => Array.isArray(v) && v.length === 2`},
+		{"struct", document{Data: []byte{4}}, `v => Buffer.isBuffer(v.data) && v.parent === undefined && "parent" in v`},
+		{"nil pointer", (*user)(nil), `v => v === undefined`},
+		{"slice of nil pointers", []*user{nil, {Name: "Ana"}}, `v => v.length === 2 && v[0] === undefined && v[1].name === "Ana"`},
+		{"map of nil pointer", map[string]*int{"a": nil}, `v => "a" in v && v.a === undefined`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				value, err := napi.ValueOf(env, test.value)
+				if err != nil {
+					t.Fatal(err)
+				} else if value == nil {
+					t.Fatal("ValueOf return nil value")
+				}
+				check, err := napi.CompileFunction(env, test.check)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res, err := check.Call(value); err != nil {
+					t.Fatal(err)
+				} else if got := describe(t, res); got != "true" {
+					t.Errorf("ValueOf(%#v) = %s, check %s failed", test.value, describe(t, value), test.check)
+				}
+			})
+		})
+	}
+}
+
+func TestValueFromBuffer(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		type blob []byte
+		var got blob
+		if err := napi.ValueFrom(script(t, env, `Buffer.from([1, 2, 3])`), &got); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(got, blob{1, 2, 3}) {
+			t.Errorf("ValueFrom(Buffer) = %v, want [1 2 3]", got)
+		}
+
+		// Decoded bytes are a copy, not Buffer memory
+		buf := script(t, env, `globalThis.sharedBuffer = Buffer.from([1]); sharedBuffer`)
+		var data []byte
+		if err := napi.ValueFrom(buf, &data); err != nil {
+			t.Fatal(err)
+		}
+		data[0] = 9
+		if got := describe(t, script(t, env, `sharedBuffer[0]`)); got != "1" {
+			t.Errorf("change of decoded bytes changed Buffer to %s", got)
+		}
+
+		var numbers []int
+		if err := napi.ValueFrom(script(t, env, `Buffer.from([1])`), &numbers); err == nil {
+			t.Errorf("ValueFrom(Buffer, *[]int) = %v, want error", numbers)
+		}
+	})
+}
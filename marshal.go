@@ -0,0 +1,17 @@
+package napi
+
+// Marshaler is implemented by types that convert itself to Javascript value,
+// [ValueOf] call MarshalNapi before reflection.
+//
+// napi-go bind generate MarshalNapi to structs without reflection.
+type Marshaler interface {
+	MarshalNapi(env EnvType) (ValueType, error)
+}
+
+// Unmarshaler is implemented by types that decode Javascript value to itself,
+// [ValueFrom] and [ValueFromCoerce] call UnmarshalNapi before reflection.
+//
+// napi-go bind generate UnmarshalNapi to structs without reflection.
+type Unmarshaler interface {
+	UnmarshalNapi(value ValueType) error
+}
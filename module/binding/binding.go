@@ -1,6 +1,6 @@
 // Export values to Javascript
 //
-// Deprecated: Use [sirherobrine23.com.br/Sirherobrine23/napi-go/entry] to linking, in final release this module ar to remove
+// Deprecated: Use [napi.OnInit], [napi.Export] or [napi.ExportFunc] with [sirherobrine23.com.br/Sirherobrine23/napi-go/entry] to linking, in final release this module ar to remove
 package entry
 
 import (
@@ -12,16 +12,15 @@ import (
 
 type registerCallback func(env napi.EnvType, object *napi.Object)
 
-var modFuncInit = []registerCallback{}
-
 //go:linkname start sirherobrine23.com.br/Sirherobrine23/napi-go/module.Register
-func start(env napi.EnvType, export *napi.Object) {
-	for _, registerCall := range modFuncInit {
-		registerCall(env, export)
-	}
-}
+func start(env napi.EnvType, export *napi.Object) {}
 
 // Register callback to register export values
+//
+// Deprecated: Use [napi.OnInit].
 func Register(fn registerCallback) {
-	modFuncInit = append(modFuncInit, fn)
+	napi.OnInit(func(env napi.EnvType, exports *napi.Object) error {
+		fn(env, exports)
+		return nil
+	})
 }
@@ -9,15 +9,21 @@
 //
 // The Register function must be linked using go:linkname and is intended to be implemented by the
 // user to define the module's exported functions and properties. See the provided example in the
-// comments for usage details.
+// comments for usage details. Values registered by napi.Export, napi.ExportFunc and napi.OnInit are
+// set after Register, to addons without Register use package entry.
 package module
 
 /*
 #cgo CFLAGS: -DDEBUG
 #cgo CFLAGS: -D_DEBUG
 #cgo CFLAGS: -DV8_ENABLE_CHECKS
-#cgo CFLAGS: -DNAPI_EXPERIMENTAL
-#cgo CFLAGS: -I/usr/local/include/node
+#cgo !napi8,!napi9,!napi10 CFLAGS: -DNAPI_EXPERIMENTAL
+#cgo napi8 CFLAGS: -DNAPI_VERSION=8
+#cgo napi9 CFLAGS: -DNAPI_VERSION=9
+#cgo napi10 CFLAGS: -DNAPI_VERSION=10
+#cgo !napi_system_headers CPPFLAGS: -I${SRCDIR}/../internal/napi/include
+#cgo napi_system_headers CFLAGS: -I/usr/local/include
+#cgo CFLAGS: -DNODE_API_EXPERIMENTAL_BASIC_ENV_OPT_OUT
 #cgo CXXFLAGS: -std=c++11
 
 #cgo darwin LDFLAGS: -Wl,-undefined,dynamic_lookup
@@ -36,6 +42,7 @@ package module
 import "C"
 
 import (
+	"errors"
 	"fmt"
 	_ "unsafe"
 
@@ -68,10 +75,21 @@ func initializeModule(cEnv C.napi_env, cExports C.napi_value) C.napi_value {
 	// Call register
 	Register(env, export)
 
+	// Set values registered by napi.Export, napi.ExportFunc and napi.OnInit
+	if err := initModule(env, export); err != nil {
+		if !errors.Is(err, napi.ErrPendingException) {
+			internal_napi.ThrowError(env.NapiValue(), "", err.Error())
+		}
+		return nil
+	}
+
 	// return value
 	return cExports
 }
 
+//go:linkname initModule sirherobrine23.com.br/Sirherobrine23/napi-go.initModule
+func initModule(env napi.EnvType, exports *napi.Object) error
+
 // Function to register N-API module functions and other on export Object,
 // this function require use go:linkname to link register function.
 // Se https://pkg.go.dev/cmd/compile#hdr-Linkname_Directive to how link Register function.
@@ -0,0 +1,14 @@
+//go:build !cgo
+
+package module
+
+import (
+	_ "unsafe"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+)
+
+// Without cgo addon can't be built and Register is never called, declared to link Register of addon.
+//
+//go:linkname Register
+func Register(env napi.EnvType, export *napi.Object)
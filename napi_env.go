@@ -10,6 +10,10 @@ type EnvType interface {
 	Global() (*Object, error)
 	Undefined() (ValueType, error)
 	Null() (ValueType, error)
+	NapiVersion() (uint32, error)
+	RunScript(source string) (ValueType, error)
+	ModuleFileName() (string, error)
+	Require(specifier string) (ValueType, error)
 }
 
 // Return N-API env reference
@@ -27,8 +31,8 @@ func (e *_Env) NapiValue() napi.Env {
 
 // Return representantion to 'This' [*Object]
 func (e *_Env) Global() (*Object, error) {
-	napiValue, err := mustValueErr(napi.GetGlobal(e.NapiEnv))
-	if err != nil {
+	napiValue, status := napi.GetGlobal(e.NapiEnv)
+	if err := statusError(e.NapiEnv, "napi_get_global", status); err != nil {
 		return nil, err
 	}
 	return ToObject(N_APIValue(e, napiValue)), nil
@@ -36,8 +40,8 @@ func (e *_Env) Global() (*Object, error) {
 
 // Return Undefined value
 func (e *_Env) Undefined() (ValueType, error) {
-	napiValue, err := mustValueErr(napi.GetUndefined(e.NapiEnv))
-	if err != nil {
+	napiValue, status := napi.GetUndefined(e.NapiEnv)
+	if err := statusError(e.NapiEnv, "napi_get_undefined", status); err != nil {
 		return nil, err
 	}
 	return N_APIValue(e, napiValue), nil
@@ -45,9 +49,37 @@ func (e *_Env) Undefined() (ValueType, error) {
 
 // Return Null value
 func (e *_Env) Null() (ValueType, error) {
-	napiValue, err := mustValueErr(napi.GetNull(e.NapiEnv))
+	napiValue, status := napi.GetNull(e.NapiEnv)
+	if err := statusError(e.NapiEnv, "napi_get_null", status); err != nil {
+		return nil, err
+	}
+	return N_APIValue(e, napiValue), nil
+}
+
+// Run Javascript source in global scope, same as indirect eval, and return the value of last expression.
+// If script throws, the exception is cleared from env and returned as [*Exception].
+func (e *_Env) RunScript(source string) (ValueType, error) {
+	script, err := CreateString(e, source)
 	if err != nil {
 		return nil, err
 	}
+	napiValue, status := napi.RunScript(e.NapiEnv, script.NapiValue())
+	if err := statusError(e.NapiEnv, "napi_run_script", status); err != nil {
+		// Compile errors return napi_generic_failure with SyntaxError pending
+		if pending, _ := napi.IsExceptionPending(e.NapiEnv); pending {
+			return nil, lastException(e)
+		}
+		return nil, err
+	}
 	return N_APIValue(e, napiValue), nil
 }
+
+// Return URL of addon file loaded by Node, example "file:///home/user/addon/build/addon.node".
+// Require Node-API 9, else return error wrapping [errors.ErrUnsupported].
+func (e *_Env) ModuleFileName() (string, error) {
+	if err := unsupported(FeatureModuleFileName); err != nil {
+		return "", err
+	}
+	fileName, status := napi.GetModuleFileName(e.NapiEnv)
+	return fileName, statusError(e.NapiEnv, "node_api_get_module_file_name", status)
+}
@@ -1,18 +1,106 @@
 package napi
 
-import "sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+import (
+	"fmt"
 
-// Process status to return error if StatusOK return nil on error
-func mustValueErr[T any](input T, status napi.Status) (T, error) {
-	return input, status.ToError()
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// Status is the integral status code returned by every Node-API call.
+type Status = napi.Status
+
+// Node-API status codes
+const (
+	StatusOK                            Status = napi.StatusOK
+	StatusInvalidArg                    Status = napi.StatusInvalidArg
+	StatusObjectExpected                Status = napi.StatusObjectExpected
+	StatusStringExpected                Status = napi.StatusStringExpected
+	StatusNameExpected                  Status = napi.StatusNameExpected
+	StatusFunctionExpected              Status = napi.StatusFunctionExpected
+	StatusNumberExpected                Status = napi.StatusNumberExpected
+	StatusBooleanExpected               Status = napi.StatusBooleanExpected
+	StatusArrayExpected                 Status = napi.StatusArrayExpected
+	StatusGenericFailure                Status = napi.StatusGenericFailure
+	StatusPendingException              Status = napi.StatusPendingException
+	StatusCancelled                     Status = napi.StatusCancelled
+	StatusEscapeCalledTwice             Status = napi.StatusEscapeCalledTwice
+	StatusHandleScopeMismatch           Status = napi.StatusHandleScopeMismatch
+	StatusCallbackScopeMismatch         Status = napi.StatusCallbackScopeMismatch
+	StatusQueueFull                     Status = napi.StatusQueueFull
+	StatusClosing                       Status = napi.StatusClosing
+	StatusBigintExpected                Status = napi.StatusBigintExpected
+	StatusDateExpected                  Status = napi.StatusDateExpected
+	StatusArraybufferExpected           Status = napi.StatusArraybufferExpected
+	StatusDetachableArraybufferExpected Status = napi.StatusDetachableArraybufferExpected
+	StatusWouldDeadlock                 Status = napi.StatusWouldDeadlock
+)
+
+// Sentinel errors for each Node-API status, every [*StatusError] match
+// the sentinel of its status with [errors.Is]:
+//
+//	if errors.Is(err, napi.ErrPendingException) {
+//		return nil, nil // exception already pending in javascript
+//	}
+var (
+	ErrInvalidArg                    error = napi.StatusError(napi.StatusInvalidArg)
+	ErrObjectExpected                error = napi.StatusError(napi.StatusObjectExpected)
+	ErrStringExpected                error = napi.StatusError(napi.StatusStringExpected)
+	ErrNameExpected                  error = napi.StatusError(napi.StatusNameExpected)
+	ErrFunctionExpected              error = napi.StatusError(napi.StatusFunctionExpected)
+	ErrNumberExpected                error = napi.StatusError(napi.StatusNumberExpected)
+	ErrBooleanExpected               error = napi.StatusError(napi.StatusBooleanExpected)
+	ErrArrayExpected                 error = napi.StatusError(napi.StatusArrayExpected)
+	ErrGenericFailure                error = napi.StatusError(napi.StatusGenericFailure)
+	ErrPendingException              error = napi.StatusError(napi.StatusPendingException)
+	ErrCancelled                     error = napi.StatusError(napi.StatusCancelled)
+	ErrEscapeCalledTwice             error = napi.StatusError(napi.StatusEscapeCalledTwice)
+	ErrHandleScopeMismatch           error = napi.StatusError(napi.StatusHandleScopeMismatch)
+	ErrCallbackScopeMismatch         error = napi.StatusError(napi.StatusCallbackScopeMismatch)
+	ErrQueueFull                     error = napi.StatusError(napi.StatusQueueFull)
+	ErrClosing                       error = napi.StatusError(napi.StatusClosing)
+	ErrBigintExpected                error = napi.StatusError(napi.StatusBigintExpected)
+	ErrDateExpected                  error = napi.StatusError(napi.StatusDateExpected)
+	ErrArraybufferExpected           error = napi.StatusError(napi.StatusArraybufferExpected)
+	ErrDetachableArraybufferExpected error = napi.StatusError(napi.StatusDetachableArraybufferExpected)
+	ErrWouldDeadlock                 error = napi.StatusError(napi.StatusWouldDeadlock)
+)
+
+// StatusError is returned by every failed Node-API call, it carries the
+// status code, the Node-API function called and the extended error info
+// reported by napi_get_last_error_info.
+type StatusError struct {
+	Op              string // Node-API function called, example: napi_get_property
+	Status          Status // Node-API status code
+	Message         string // Textual representation of the error from Node-API, can be empty
+	EngineErrorCode int32  // VM specific error code
 }
 
-// return error from status
-func singleMustValueErr(status napi.Status) error {
-	return status.ToError()
+var _ error = &StatusError{}
+
+func (err *StatusError) Error() string {
+	if err.Message == "" {
+		return fmt.Sprintf("%s: %s", err.Op, err.Status)
+	}
+	return fmt.Sprintf("%s: %s: %s", err.Op, err.Status, err.Message)
 }
 
-// Process status to return error if StatusOK return nil on error
-func mustValueErr2[T any](input T, _ bool, status napi.Status) (T, error) {
-	return input, status.ToError()
+// Unwrap return sentinel error of status, example [ErrObjectExpected]
+func (err *StatusError) Unwrap() error { return napi.StatusError(err.Status) }
+
+// Return nil if status is napi_ok, else return [*StatusError] with extended error info from env.
+//
+// env can be nil if called outside of javascript thread, extended error info is only valid in javascript thread.
+func statusError(env napi.Env, op string, status napi.Status) error {
+	if status == napi.StatusOK {
+		return nil
+	}
+
+	err := &StatusError{Op: op, Status: status}
+	if env != nil {
+		if info, infoStatus := napi.GetExtendedErrorInfo(env); infoStatus == napi.StatusOK && info != nil {
+			err.Message = info.Message
+			err.EngineErrorCode = info.EngineErrorCode
+		}
+	}
+	return err
 }
@@ -0,0 +1,44 @@
+package napi_test
+
+import (
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+func TestMain(m *testing.M) { napitest.Main(m) }
+
+// Describe Javascript value as string, Buffer as hex and bigint with n suffix
+const describeSource = `function describe(value) {
+	if (Buffer.isBuffer(value)) return "Buffer<" + value.toString("hex") + ">";
+	if (value instanceof Date) return "Date<" + value.toISOString() + ">";
+	return JSON.stringify(value, (_, v) => typeof v === "bigint" ? v + "n" : v === undefined ? "undefined" : v) ?? "undefined";
+}`
+
+func describe(t *napitest.T, value napi.ValueType) string {
+	t.Helper()
+	fn, err := napi.CompileFunction(value.Env(), describeSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := fn.Call(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	str, err := napi.ToString(res).Utf8Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return str
+}
+
+// Run Javascript source and return value
+func script(t *napitest.T, env napi.EnvType, source string) napi.ValueType {
+	t.Helper()
+	value, err := env.RunScript(source)
+	if err != nil {
+		t.Fatalf("%s: %s", source, err)
+	}
+	return value
+}
@@ -119,39 +119,39 @@ func (v *_Value) NapiEnv() napi.Env     { return v.env.NapiValue() }
 func (v *_Value) Env() EnvType          { return v.env }
 func (v *_Value) Type() (NapiType, error) {
 	isTypedArray, status := napi.IsTypedArray(v.NapiEnv(), v.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(v.NapiEnv(), "napi_is_typedarray", status); err != nil {
 		return TypeUnkown, err
 	}
 	isPromise, status := napi.IsPromise(v.NapiEnv(), v.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(v.NapiEnv(), "napi_is_promise", status); err != nil {
 		return TypeUnkown, err
 	}
 	isDataView, status := napi.IsDataView(v.NapiEnv(), v.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(v.NapiEnv(), "napi_is_dataview", status); err != nil {
 		return TypeUnkown, err
 	}
 	isBuffer, status := napi.IsBuffer(v.NapiEnv(), v.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(v.NapiEnv(), "napi_is_buffer", status); err != nil {
 		return TypeUnkown, err
 	}
 	isDate, status := napi.IsDate(v.NapiEnv(), v.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(v.NapiEnv(), "napi_is_date", status); err != nil {
 		return TypeUnkown, err
 	}
 	isArray, status := napi.IsArray(v.NapiEnv(), v.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(v.NapiEnv(), "napi_is_array", status); err != nil {
 		return TypeUnkown, err
 	}
 	isArrayBuffer, status := napi.IsArrayBuffer(v.NapiEnv(), v.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(v.NapiEnv(), "napi_is_arraybuffer", status); err != nil {
 		return TypeUnkown, err
 	}
 	isError, status := napi.IsError(v.NapiEnv(), v.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(v.NapiEnv(), "napi_is_error", status); err != nil {
 		return TypeUnkown, err
 	}
 	isTypeof, status := napi.Typeof(v.NapiEnv(), v.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(v.NapiEnv(), "napi_typeof", status); err != nil {
 		return TypeUnkown, err
 	}
 
@@ -199,7 +199,7 @@ func (v *_Value) Type() (NapiType, error) {
 // This API represents the invocation of the Strict Equality algorithm as defined in https://tc39.github.io/ecma262/#sec-strict-equality-comparison of the ECMAScript Language Specification.
 func StrictEqual(env EnvType, lhs, rhs ValueType) (bool, error) {
 	ok, status := napi.StrictEquals(env.NapiValue(), lhs.NapiValue(), rhs.NapiValue())
-	return ok, status.ToError()
+	return ok, statusError(env.NapiValue(), "napi_strict_equals", status)
 }
 
 // Casts to another type of [ValueType], when the actual type is known or
@@ -0,0 +1,227 @@
+// Package napitest run Go tests inside of Node.js process, with real [napi.EnvType].
+//
+// [Main] build test binary of package as addon (go test -c -buildmode=c-shared), load addon in node
+// and run tests in node process, output and exit code are same of go test:
+//
+//	func TestMain(m *testing.M) { napitest.Main(m) }
+//
+//	func TestValueOf(t *testing.T) {
+//		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+//			value, err := napi.ValueOf(env, []int{1, 2})
+//			if err != nil {
+//				t.Fatal(err)
+//			}
+//			...
+//		})
+//	}
+//
+// Functions passed to [Run] are called in Javascript thread, async values (AsyncWorker, ThreadsafeFunction, Promise)
+// are resolved after Run return, wait for them in test goroutine.
+//
+// napitest import package entry, addons with module.Register cannot be tested, use [napi.OnInit].
+// If node not found, tests with [Run] are skipped.
+package napitest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"testing"
+	_ "unsafe" // go:linkname
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	_ "sirherobrine23.com.br/Sirherobrine23/napi-go/entry"
+)
+
+// Environment variables to configure napitest
+const (
+	EnvNode       = "NAPITEST_NODE"       // node binary, default is node in PATH
+	EnvBuildFlags = "NAPITEST_BUILDFLAGS" // extra flags to go test -c, example "-tags=napi8"
+)
+
+// Environment variables to node process
+const (
+	envAddon = "NAPITEST_ADDON" // addon path, only set inside node
+	envArgs  = "NAPITEST_ARGS"  // test flags in json
+)
+
+var (
+	dispatcher *napi.ThreadsafeFunction // Call functions in Javascript thread
+	skipReason string                   // Reason to skip Run
+)
+
+// main of test binary, in c-shared Go not call main.main
+//
+//go:linkname testMain main.main
+func testMain()
+
+func init() {
+	if os.Getenv(envAddon) == "" {
+		return
+	}
+	napi.OnInit(func(env napi.EnvType, exports *napi.Object) (err error) {
+		dispatcher, err = napi.CreateThreadsafeFunction(env, nil, nil, func(env napi.EnvType, _ *napi.Function, data any) {
+			data.(func(napi.EnvType))(env)
+		}, "napitest", 0, 1, nil)
+		if err != nil {
+			return err
+		}
+		go testMain() // Run tests while node event loop is running
+		return nil
+	})
+}
+
+// Main build and run tests inside node process, call in TestMain of package.
+//
+// Main not return, exit with code of tests.
+func Main(m *testing.M) {
+	if os.Getenv(envAddon) != "" { // Inside node
+		var args []string
+		if err := json.Unmarshal([]byte(os.Getenv(envArgs)), &args); err != nil {
+			fmt.Fprintf(os.Stderr, "napitest: invalid %s: %s\n", envArgs, err)
+			os.Exit(1)
+		}
+		os.Args = append(os.Args[:1], args...) // os.Args is node args
+		os.Exit(m.Run())
+	}
+	os.Exit(runNode(m))
+}
+
+// Build test binary as addon and run in node, return exit code
+func runNode(m *testing.M) int {
+	nodeBin := os.Getenv(EnvNode)
+	if nodeBin == "" {
+		nodeBin = "node"
+	}
+	node, err := exec.LookPath(nodeBin)
+	if err != nil {
+		skipReason = fmt.Sprintf("napitest: node not found: %s", err)
+		return m.Run()
+	}
+
+	dir, err := os.MkdirTemp("", "napitest")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "napitest: %s\n", err)
+		return 1
+	}
+	defer os.RemoveAll(dir)
+
+	addon := filepath.Join(dir, "addon.node")
+	args := []string{"test", "-c", "-buildmode=c-shared", "-o", addon}
+	if mode := testing.CoverMode(); mode != "" {
+		args = append(args, "-cover", "-covermode="+mode)
+	}
+	args = append(args, strings.Fields(os.Getenv(EnvBuildFlags))...)
+	build := exec.Command("go", append(args, ".")...)
+	build.Env = append(os.Environ(), "CGO_ENABLED=1") // c-shared require cgo, test binary can be built without
+	build.Stdout, build.Stderr = os.Stderr, os.Stderr
+	if err := build.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "napitest: build addon: %s\n", err)
+		return 1
+	}
+
+	testArgs, err := json.Marshal(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "napitest: %s\n", err)
+		return 1
+	}
+	cmd := exec.Command(node, "-e", "require(process.env."+envAddon+")")
+	cmd.Env = append(os.Environ(), envAddon+"="+addon, envArgs+"="+string(testArgs))
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+			return exitErr.ExitCode()
+		}
+		fmt.Fprintf(os.Stderr, "napitest: node: %s\n", err)
+		return 1
+	}
+	return 0
+}
+
+// T is [*testing.T] to functions called by [Run] in Javascript thread,
+// FailNow, Fatal, SkipNow and Skip stop function and test after return to test goroutine.
+type T struct {
+	*testing.T
+}
+
+// Panic values to stop function in Javascript thread
+type (
+	failNow struct{}
+	skipNow struct{}
+)
+
+func (t *T) FailNow() { t.T.Fail(); panic(failNow{}) }
+func (t *T) SkipNow() { panic(skipNow{}) }
+
+func (t *T) Fatal(args ...any) {
+	t.T.Helper()
+	t.T.Error(args...)
+	panic(failNow{})
+}
+
+func (t *T) Fatalf(format string, args ...any) {
+	t.T.Helper()
+	t.T.Errorf(format, args...)
+	panic(failNow{})
+}
+
+func (t *T) Skip(args ...any) {
+	t.T.Helper()
+	t.T.Log(args...)
+	panic(skipNow{})
+}
+
+func (t *T) Skipf(format string, args ...any) {
+	t.T.Helper()
+	t.T.Logf(format, args...)
+	panic(skipNow{})
+}
+
+// Run call fn in Javascript thread with env of node process and wait fn return.
+// Panic in fn fail the test.
+func Run(t *testing.T, fn func(t *T, env napi.EnvType)) {
+	t.Helper()
+	if dispatcher == nil {
+		if skipReason != "" {
+			t.Skip(skipReason)
+		}
+		t.Fatal("napitest: not running in node, call napitest.Main in TestMain")
+	}
+
+	type result struct {
+		value any
+		stack []byte
+	}
+	done := make(chan result, 1)
+	err := dispatcher.Call(func(env napi.EnvType) {
+		defer func() {
+			value := recover()
+			switch value.(type) {
+			case nil, failNow, skipNow:
+				done <- result{value: value}
+			default:
+				done <- result{value, debug.Stack()}
+			}
+		}()
+		fn(&T{t}, env)
+	}, napi.Blocking)
+	if err != nil {
+		t.Fatalf("napitest: %s", err)
+	}
+
+	switch res := <-done; res.value.(type) {
+	case nil:
+	case failNow:
+		t.FailNow()
+	case skipNow:
+		t.SkipNow()
+	default:
+		t.Fatalf("panic: %v\n%s", res.value, res.stack)
+	}
+}
@@ -0,0 +1 @@
+// Allow body-less function with go:linkname
@@ -0,0 +1,132 @@
+package napitest_test
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+func TestMain(m *testing.M) { napitest.Main(m) }
+
+// Set to run tests expected to fail, checked by TestReportFailures in other go test
+const envFailCases = "NAPITEST_FAIL_CASES"
+
+func failCase(t *testing.T) {
+	if os.Getenv(envFailCases) == "" {
+		t.Skip("expected to fail, run by TestReportFailures")
+	}
+}
+
+func TestPass(t *testing.T) {
+	var called bool
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		called = true
+		global, err := env.Global()
+		if err != nil {
+			t.Fatal(err)
+		}
+		process, err := global.Get("process")
+		if err != nil {
+			t.Fatal(err)
+		} else if typeOf, _ := process.Type(); typeOf != napi.TypeObject {
+			t.Errorf("process is %s, want object", typeOf)
+		}
+	})
+	if !called {
+		t.Error("Run returned without call function")
+	}
+}
+
+func TestSubtests(t *testing.T) {
+	var calls []string
+	for _, name := range []string{"first", "second"} {
+		t.Run(name, func(t *testing.T) {
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				calls = append(calls, t.Name())
+			})
+		})
+	}
+	if want := "TestSubtests/first,TestSubtests/second"; strings.Join(calls, ",") != want {
+		t.Errorf("called in %v, want %s", calls, want)
+	}
+}
+
+func TestFatalCase(t *testing.T) {
+	failCase(t)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		t.Fatal("fatal in javascript thread")
+		t.Log("after fatal in javascript thread")
+	})
+	t.Log("after fatal in test goroutine")
+}
+
+func TestPanicCase(t *testing.T) {
+	failCase(t)
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		panic("panic in javascript thread")
+	})
+}
+
+func TestSubtestFailCase(t *testing.T) {
+	failCase(t)
+	t.Run("fail", func(t *testing.T) {
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) { t.Error("error in subtest") })
+	})
+	t.Run("pass", func(t *testing.T) {
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {})
+	})
+}
+
+// Run fail cases in other go test and check go test output and exit code
+func TestReportFailures(t *testing.T) {
+	if os.Getenv(envFailCases) != "" {
+		t.Skip("running fail cases")
+	} else if testing.Short() {
+		t.Skip("build addon again in -short mode")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	cmd := exec.Command(goBin, "test", "-count=1", "-v", "-run", "^Test(Fatal|Panic|SubtestFail)Case$", ".")
+	for _, env := range os.Environ() { // Inside node, remove addon variables of this process
+		if !strings.HasPrefix(env, "NAPITEST_ADDON=") && !strings.HasPrefix(env, "NAPITEST_ARGS=") {
+			cmd.Env = append(cmd.Env, env)
+		}
+	}
+	cmd.Env = append(cmd.Env, envFailCases+"=1")
+	output, err := cmd.CombinedOutput()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+		t.Fatalf("go test exit with %v, want exit code 1\n%s", err, output)
+	}
+
+	out := string(output)
+	for _, want := range []string{
+		"--- FAIL: TestFatalCase",
+		"fatal in javascript thread",
+		"--- FAIL: TestPanicCase",
+		"panic: panic in javascript thread",
+		"--- FAIL: TestSubtestFailCase/fail",
+		"error in subtest",
+		"--- PASS: TestSubtestFailCase/pass",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output not contains %q", want)
+		}
+	}
+	for _, notWant := range []string{"after fatal in javascript thread", "after fatal in test goroutine"} {
+		if strings.Contains(out, notWant) {
+			t.Errorf("output contains %q, Fatal not stopped test", notWant)
+		}
+	}
+	if t.Failed() {
+		t.Logf("go test output:\n%s", output)
+	}
+}
@@ -8,8 +8,8 @@ type NodeVersion = napi.NodeVersion
 // It takes an EnvType as input and returns a pointer to a NodeVersion struct and an error.
 // If the version retrieval fails, it returns a non-nil error.
 func GetNodeVersion(env EnvType) (*NodeVersion, error) {
-	version, err := napi.GetNodeVersion(env.NapiValue())
-	if err := err.ToError(); err != nil {
+	version, status := napi.GetNodeVersion(env.NapiValue())
+	if err := statusError(env.NapiValue(), "napi_get_node_version", status); err != nil {
 		return nil, err
 	}
 	return &version, nil
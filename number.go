@@ -1,7 +1,7 @@
 package napi
 
 import (
-	"fmt"
+	"math"
 
 	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
 )
@@ -15,44 +15,61 @@ func ToNumber(o ValueType) *Number { return &Number{o} }
 // Convert [ValueType] to [*Bigint]
 func ToBigint(o ValueType) *Bigint { return &Bigint{o} }
 
+// CoerceNumber converts any [ValueType] to [*Number], same as Number(value) in Javascript.
+//
+// BigInt and Symbol cannot be converted, napi_coerce_to_number throw TypeError in Javascript.
+func CoerceNumber(value ValueType) (*Number, error) {
+	napiValue, status := napi.CoerceToNumber(value.NapiEnv(), value.NapiValue())
+	if err := statusError(value.NapiEnv(), "napi_coerce_to_number", status); err != nil {
+		return nil, err
+	}
+	return ToNumber(N_APIValue(value.Env(), napiValue)), nil
+}
+
 // Float returns the float64 representation of the Number.
 // It retrieves the underlying value from the N-API environment and value handle.
 // If the conversion fails, an error is returned.
 func (num *Number) Float() (float64, error) {
-	return mustValueErr(napi.GetValueDouble(num.NapiEnv(), num.NapiValue()))
+	value, status := napi.GetValueDouble(num.NapiEnv(), num.NapiValue())
+	return value, statusError(num.NapiEnv(), "napi_get_value_double", status)
 }
 
 // Int returns the int64 representation of the Number.
 // It calls napi.GetValueInt64 using the underlying NapiEnv and NapiValue.
 // If the conversion fails, an error is returned.
 func (num *Number) Int() (int64, error) {
-	return mustValueErr(napi.GetValueInt64(num.NapiEnv(), num.NapiValue()))
+	value, status := napi.GetValueInt64(num.NapiEnv(), num.NapiValue())
+	return value, statusError(num.NapiEnv(), "napi_get_value_int64", status)
 }
 
 // Uint32 retrieves the value of the Number as a uint32.
 // It returns the uint32 representation of the Number and an error if the conversion fails.
 func (num *Number) Uint32() (uint32, error) {
-	return mustValueErr(napi.GetValueUint32(num.NapiEnv(), num.NapiValue()))
+	value, status := napi.GetValueUint32(num.NapiEnv(), num.NapiValue())
+	return value, statusError(num.NapiEnv(), "napi_get_value_uint32", status)
 }
 
 // Int32 retrieves the value of the Number as an int32.
 // It returns the int32 representation of the Number and an error if the conversion fails.
 func (num *Number) Int32() (int32, error) {
-	return mustValueErr(napi.GetValueInt32(num.NapiEnv(), num.NapiValue()))
+	value, status := napi.GetValueInt32(num.NapiEnv(), num.NapiValue())
+	return value, statusError(num.NapiEnv(), "napi_get_value_int32", status)
 }
 
 // Int64 returns the value of the Bigint as an int64 along with an error if the conversion fails.
 // It retrieves the int64 representation of the underlying N-API BigInt value.
 // If the value cannot be represented as an int64, an error is returned.
 func (big *Bigint) Int64() (int64, error) {
-	return mustValueErr2(napi.GetValueBigIntInt64(big.NapiEnv(), big.NapiValue()))
+	value, _, status := napi.GetValueBigIntInt64(big.NapiEnv(), big.NapiValue())
+	return value, statusError(big.NapiEnv(), "napi_get_value_bigint_int64", status)
 }
 
 // Uint64 returns the value of the Bigint as a uint64 along with an error if the conversion fails.
 // It retrieves the underlying BigInt value from the N-API environment and attempts to convert it to a uint64.
 // If the value cannot be represented as a uint64 or if an error occurs during retrieval, an error is returned.
 func (big *Bigint) Uint64() (uint64, error) {
-	return mustValueErr2(napi.GetValueBigIntUint64(big.NapiEnv(), big.NapiValue()))
+	value, _, status := napi.GetValueBigIntUint64(big.NapiEnv(), big.NapiValue())
+	return value, statusError(big.NapiEnv(), "napi_get_value_bigint_uint64", status)
 }
 
 // CreateBigint creates a new Bigint value in the given N-API environment from the provided int64 or uint64 value.
@@ -60,18 +77,19 @@ func (big *Bigint) Uint64() (uint64, error) {
 // It returns a pointer to a Bigint and an error if the creation fails.
 func CreateBigint[T int64 | uint64](env EnvType, valueOf T) (*Bigint, error) {
 	var value napi.Value
-	var err error
+	var status napi.Status
+	var op string
 	switch v := any(valueOf).(type) {
 	case int64:
-		if value, err = mustValueErr(napi.CreateBigIntInt64(env.NapiValue(), v)); err != nil {
-			return nil, err
-		}
+		op = "napi_create_bigint_int64"
+		value, status = napi.CreateBigIntInt64(env.NapiValue(), v)
 	case uint64:
-		if value, err = mustValueErr(napi.CreateBigIntUint64(env.NapiValue(), v)); err != nil {
-			return nil, err
-		}
+		op = "napi_create_bigint_uint64"
+		value, status = napi.CreateBigIntUint64(env.NapiValue(), v)
+	}
+	if err := statusError(env.NapiValue(), op, status); err != nil {
+		return nil, err
 	}
-
 	return ToBigint(N_APIValue(env, value)), nil
 }
 
@@ -81,58 +99,51 @@ func CreateBigint[T int64 | uint64](env EnvType, valueOf T) (*Bigint, error) {
 // value to the appropriate JavaScript number representation using the N-API environment.
 func CreateNumber[T ~int | ~uint | ~int8 | ~uint8 | ~int16 | ~uint16 | ~int32 | ~uint32 | ~int64 | ~uint64 | ~float32 | ~float64](env EnvType, n T) (*Number, error) {
 	var value napi.Value
-	var err error
+	var status napi.Status
+	op := "napi_create_int64"
 	switch v := any(n).(type) {
 	case int:
-		if value, err = mustValueErr(napi.CreateInt64(env.NapiValue(), int64(v))); err != nil {
-			return nil, err
-		}
+		value, status = napi.CreateInt64(env.NapiValue(), int64(v))
 	case uint:
-		if value, err = mustValueErr(napi.CreateInt64(env.NapiValue(), int64(v))); err != nil {
-			return nil, err
-		}
+		value, status = napi.CreateInt64(env.NapiValue(), int64(v))
 	case int8:
-		if value, err = mustValueErr(napi.CreateInt64(env.NapiValue(), int64(v))); err != nil {
-			return nil, err
-		}
+		value, status = napi.CreateInt64(env.NapiValue(), int64(v))
 	case uint8:
-		if value, err = mustValueErr(napi.CreateInt64(env.NapiValue(), int64(v))); err != nil {
-			return nil, err
-		}
+		value, status = napi.CreateInt64(env.NapiValue(), int64(v))
 	case int16:
-		if value, err = mustValueErr(napi.CreateInt64(env.NapiValue(), int64(v))); err != nil {
-			return nil, err
-		}
+		value, status = napi.CreateInt64(env.NapiValue(), int64(v))
 	case uint16:
-		if value, err = mustValueErr(napi.CreateInt64(env.NapiValue(), int64(v))); err != nil {
-			return nil, err
-		}
+		value, status = napi.CreateInt64(env.NapiValue(), int64(v))
 	case int32:
-		if value, err = mustValueErr(napi.CreateInt32(env.NapiValue(), v)); err != nil {
-			return nil, err
-		}
+		op = "napi_create_int32"
+		value, status = napi.CreateInt32(env.NapiValue(), v)
 	case uint32:
-		if value, err = mustValueErr(napi.CreateUint32(env.NapiValue(), v)); err != nil {
-			return nil, err
-		}
+		op = "napi_create_uint32"
+		value, status = napi.CreateUint32(env.NapiValue(), v)
 	case int64:
-		if value, err = mustValueErr(napi.CreateInt64(env.NapiValue(), v)); err != nil {
-			return nil, err
-		}
+		value, status = napi.CreateInt64(env.NapiValue(), v)
 	case uint64:
-		if value, err = mustValueErr(napi.CreateInt64(env.NapiValue(), int64(v))); err != nil {
-			return nil, err
-		}
+		value, status = napi.CreateInt64(env.NapiValue(), int64(v))
 	case float32:
-		if value, err = mustValueErr(napi.CreateDouble(env.NapiValue(), float64(v))); err != nil {
-			return nil, err
-		}
+		op = "napi_create_double"
+		value, status = napi.CreateDouble(env.NapiValue(), float64(v))
 	case float64:
-		if value, err = mustValueErr(napi.CreateDouble(env.NapiValue(), v)); err != nil {
-			return nil, err
+		op = "napi_create_double"
+		value, status = napi.CreateDouble(env.NapiValue(), v)
+	default: // named types, example: type Level int
+		switch half := 0.5; {
+		case T(half) != 0: // ~float32 and ~float64
+			op = "napi_create_double"
+			value, status = napi.CreateDouble(env.NapiValue(), float64(n))
+		case T(0)-1 > 0 && uint64(n) > math.MaxInt64: // ~uint64 overflow int64
+			op = "napi_create_double"
+			value, status = napi.CreateDouble(env.NapiValue(), float64(n))
+		default:
+			value, status = napi.CreateInt64(env.NapiValue(), int64(n))
 		}
-	default:
-		return nil, fmt.Errorf("invalid number type")
 	}
-	return ToNumber(N_APIValue(env, value)), err
+	if err := statusError(env.NapiValue(), op, status); err != nil {
+		return nil, err
+	}
+	return ToNumber(N_APIValue(env, value)), nil
 }
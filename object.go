@@ -13,21 +13,35 @@ func ToObject(o ValueType) *Object { return &Object{o} }
 
 // Create [*Object]
 func CreateObject(env EnvType) (*Object, error) {
-	napiValue, err := mustValueErr(napi.CreateObject(env.NapiValue()))
-	if err != nil {
+	napiValue, status := napi.CreateObject(env.NapiValue())
+	if err := statusError(env.NapiValue(), "napi_create_object", status); err != nil {
 		return nil, err
 	}
 	return ToObject(N_APIValue(env, napiValue)), nil
 }
 
+// CoerceObject converts any [ValueType] to [*Object], same as Object(value) in Javascript,
+// primitives are wrapped in your object (String, Number, Boolean, ...).
+//
+// undefined and null cannot be converted, napi_coerce_to_object throw TypeError in Javascript.
+func CoerceObject(value ValueType) (*Object, error) {
+	napiValue, status := napi.CoerceToObject(value.NapiEnv(), value.NapiValue())
+	if err := statusError(value.NapiEnv(), "napi_coerce_to_object", status); err != nil {
+		return nil, err
+	}
+	return ToObject(N_APIValue(value.Env(), napiValue)), nil
+}
+
 // Check if exists named property.
 func (obj *Object) Has(name string) (bool, error) {
-	return mustValueErr(napi.HasNamedProperty(obj.NapiEnv(), obj.NapiValue(), name))
+	ok, status := napi.HasNamedProperty(obj.NapiEnv(), obj.NapiValue(), name)
+	return ok, statusError(obj.NapiEnv(), "napi_has_named_property", status)
 }
 
 // Checks whether a own property is present.
 func (obj *Object) HasOwnProperty(key ValueType) (bool, error) {
-	return mustValueErr(napi.HasOwnProperty(obj.NapiEnv(), obj.NapiValue(), key.NapiValue()))
+	ok, status := napi.HasOwnProperty(obj.NapiEnv(), obj.NapiValue(), key.NapiValue())
+	return ok, statusError(obj.NapiEnv(), "napi_has_own_property", status)
 }
 
 // Checks whether a own property is present.
@@ -50,8 +64,8 @@ func (obj *Object) Get(key string) (ValueType, error) {
 
 // Gets a property.
 func (obj *Object) GetWithValue(key ValueType) (ValueType, error) {
-	napiValue, err := mustValueErr(napi.GetProperty(obj.Env().NapiValue(), obj.NapiValue(), key.NapiValue()))
-	if err != nil {
+	napiValue, status := napi.GetProperty(obj.NapiEnv(), obj.NapiValue(), key.NapiValue())
+	if err := statusError(obj.NapiEnv(), "napi_get_property", status); err != nil {
 		return nil, err
 	}
 	return N_APIValue(obj.Env(), napiValue), nil
@@ -68,7 +82,7 @@ func (obj *Object) Set(key string, value ValueType) error {
 
 // Sets a property.
 func (obj *Object) SetWithValue(key, value ValueType) error {
-	return singleMustValueErr(napi.SetProperty(obj.NapiEnv(), obj.NapiValue(), key.NapiValue(), value.NapiValue()))
+	return statusError(obj.NapiEnv(), "napi_set_property", napi.SetProperty(obj.NapiEnv(), obj.NapiValue(), key.NapiValue(), value.NapiValue()))
 }
 
 // Delete property.
@@ -82,13 +96,14 @@ func (obj *Object) Delete(key string) (bool, error) {
 
 // Delete property.
 func (obj *Object) DeleteWithValue(key ValueType) (bool, error) {
-	return mustValueErr(napi.DeleteProperty(obj.NapiEnv(), obj.NapiValue(), key.NapiValue()))
+	ok, status := napi.DeleteProperty(obj.NapiEnv(), obj.NapiValue(), key.NapiValue())
+	return ok, statusError(obj.NapiEnv(), "napi_delete_property", status)
 }
 
 // Get all property names.
 func (obj *Object) GetPropertyNames() (*Array, error) {
-	arrValue, err := mustValueErr(napi.GetPropertyNames(obj.NapiEnv(), obj.NapiValue()))
-	if err != nil {
+	arrValue, status := napi.GetPropertyNames(obj.NapiEnv(), obj.NapiValue())
+	if err := statusError(obj.NapiEnv(), "napi_get_property_names", status); err != nil {
 		return nil, err
 	}
 	return ToArray(N_APIValue(obj.Env(), arrValue)), nil
@@ -97,7 +112,8 @@ func (obj *Object) GetPropertyNames() (*Array, error) {
 // Checks if an object is an instance created by a constructor function,
 // this is equivalent to the JavaScript `instanceof` operator.
 func (obj *Object) InstanceOf(value ValueType) (bool, error) {
-	return mustValueErr(napi.InstanceOf(obj.NapiEnv(), obj.NapiValue(), value.NapiValue()))
+	ok, status := napi.InstanceOf(obj.NapiEnv(), obj.NapiValue(), value.NapiValue())
+	return ok, statusError(obj.NapiEnv(), "napi_instanceof", status)
 }
 
 // This method freezes a given object.
@@ -110,7 +126,7 @@ func (obj *Object) InstanceOf(value ValueType) (bool, error) {
 //
 // It also prevents the object's prototype from being changed.
 func (obj *Object) Freeze() error {
-	return singleMustValueErr(napi.ObjectFreeze(obj.NapiEnv(), obj.NapiValue()))
+	return statusError(obj.NapiEnv(), "napi_object_freeze", napi.ObjectFreeze(obj.NapiEnv(), obj.NapiValue()))
 }
 
 // This method seals a given object.
@@ -118,7 +134,7 @@ func (obj *Object) Freeze() error {
 // This prevents new properties from being added to it,
 // as well as marking all existing properties as non-configurable.
 func (obj *Object) Seal() error {
-	return singleMustValueErr(napi.ObjectSeal(obj.NapiEnv(), obj.NapiValue()))
+	return statusError(obj.NapiEnv(), "napi_object_seal", napi.ObjectSeal(obj.NapiEnv(), obj.NapiValue()))
 }
 
 // Seq returns an iterator (Seq2) over the object's property names and their corresponding values.
@@ -0,0 +1,220 @@
+package napi
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	internalNapi "sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// OverloadError is returned when arguments not match any function of [Overload],
+// is thrown in Javascript as TypeError with code ERR_INVALID_ARG_TYPE.
+type OverloadError struct {
+	Name       string   // Javascript function name
+	Received   []string // Types of arguments
+	Signatures []string // Accepted arguments of each function
+}
+
+func (err *OverloadError) Error() string {
+	return fmt.Sprintf("The arguments (%s) do not match any signature of %s: %s", strings.Join(err.Received, ", "), err.Name, strings.Join(err.Signatures, ", "))
+}
+
+func (err *OverloadError) typeErrorCode() string { return "ERR_INVALID_ARG_TYPE" }
+
+// Go function of overload
+type overloadFunc struct {
+	fnType   reflect.Type // nil to Callback, accept any arguments
+	callback Callback
+}
+
+// Overload create Javascript function calling first Go function with parameters matching count and types of arguments,
+// functions are converted same of [GoFuncOf] and [Callback] or functions with same signature accept any arguments.
+// If no function match, throw TypeError with accepted signatures.
+//
+//	fn, err := napi.Overload(env, "readFile",
+//		func(path string) ([]byte, error) { return os.ReadFile(path) },
+//		func(path string, options struct{ Encoding string }) (string, error) { ... },
+//	)
+func Overload(env EnvType, name string, functions ...any) (*Function, error) {
+	overloads, err := overloadsOf(functions)
+	if err != nil {
+		return nil, err
+	}
+	return overloadFunction(env, name, overloads)
+}
+
+// ExportOverload register functions to be exported from addon with [Overload].
+func ExportOverload(name string, functions ...any) {
+	from := caller()
+	overloads, err := overloadsOf(functions)
+	if err != nil {
+		moduleExports.Lock()
+		moduleExports.errs = append(moduleExports.errs, fmt.Errorf("napi: export %q at %s: %w", name, from, err))
+		moduleExports.Unlock()
+		return
+	}
+	registerExport(name, from, func(env EnvType) (ValueType, error) {
+		return overloadFunction(env, name, overloads)
+	})
+}
+
+// Create function with length of overload with less arguments
+func overloadFunction(env EnvType, name string, overloads []overloadFunc) (*Function, error) {
+	fn, err := CreateFunction(env, name, overloadCallback(name, overloads))
+	if err != nil {
+		return nil, err
+	}
+	length := -1
+	for _, overload := range overloads {
+		if overload.fnType == nil {
+			length = 0
+		} else if fnLength := goFuncLength(overload.fnType); length == -1 || fnLength < length {
+			length = fnLength
+		}
+	}
+	if length > 0 {
+		if err = fn.SetLength(length); err != nil {
+			return nil, err
+		}
+	}
+	return fn, nil
+}
+
+func overloadsOf(functions []any) ([]overloadFunc, error) {
+	if len(functions) == 0 {
+		return nil, fmt.Errorf("napi: overload require functions")
+	}
+	overloads := make([]overloadFunc, len(functions))
+	for index, function := range functions {
+		ptr := reflect.ValueOf(function)
+		switch v := function.(type) {
+		case Callback:
+			overloads[index] = overloadFunc{callback: v}
+			continue
+		case internalNapi.Callback:
+			return nil, fmt.Errorf("napi: overload function %d: internal/napi Callback not supported", index)
+		}
+		if ptr.Kind() != reflect.Func || ptr.IsNil() {
+			return nil, fmt.Errorf("napi: overload function %d: require function, got %T", index, function)
+		} else if ptr.Type().ConvertibleTo(typeofCallback) { // Function literal with Callback signature
+			overloads[index] = overloadFunc{callback: ptr.Convert(typeofCallback).Interface().(Callback)}
+			continue
+		}
+		overloads[index] = overloadFunc{fnType: ptr.Type(), callback: goFuncCallback(ptr, nil)}
+	}
+	return overloads, nil
+}
+
+func overloadCallback(name string, overloads []overloadFunc) Callback {
+	return func(ci *CallbackInfo) (ValueType, error) {
+		for _, overload := range overloads {
+			if ok, err := overload.matches(ci.Env, ci.Args); err != nil {
+				return nil, err
+			} else if ok {
+				return overload.callback(ci)
+			}
+		}
+
+		err := &OverloadError{Name: name, Received: make([]string, len(ci.Args))}
+		for index, arg := range ci.Args {
+			typeOf, _ := arg.Type()
+			err.Received[index] = typeOf.String()
+		}
+		for _, overload := range overloads {
+			err.Signatures = append(err.Signatures, overload.signature())
+		}
+		return nil, err
+	}
+}
+
+// Return parameter type of Javascript argument index, nil if function not accept argument
+func (overload overloadFunc) param(index int) reflect.Type {
+	skip, numIn := goFuncSkip(overload.fnType), overload.fnType.NumIn()
+	if overload.fnType.IsVariadic() && skip+index >= numIn-1 {
+		return overload.fnType.In(numIn - 1).Elem()
+	} else if skip+index < numIn {
+		return overload.fnType.In(skip + index)
+	}
+	return nil
+}
+
+// Return true if count and types of arguments match parameters of function
+func (overload overloadFunc) matches(env EnvType, args []ValueType) (bool, error) {
+	if overload.fnType == nil {
+		return true, nil
+	}
+
+	params := overload.fnType.NumIn() - goFuncSkip(overload.fnType)
+	if overload.fnType.IsVariadic() {
+		params--
+	}
+	if overload.fnType.NumIn() > 0 && overload.fnType.In(0) == typeofContext {
+		var err error
+		if args, _, err = splitAbortSignal(env, args, params, overload.fnType.IsVariadic()); err != nil {
+			return false, err
+		}
+	}
+
+	for index := 0; index < params || index < len(args); index++ {
+		typ := overload.param(index)
+		switch {
+		case typ == nil: // Extra argument
+			return false, nil
+		case index >= len(args):
+			if !isOptionalParam(typ) {
+				return false, nil
+			}
+		default:
+			typeOf, err := args[index].Type()
+			if err != nil {
+				return false, err
+			} else if typeOf == TypeUndefined || typeOf == TypeNull {
+				if !isOptionalParam(typ) {
+					return false, nil
+				}
+			} else if types := jsTypes(typ); types != nil && !slices.Contains(types, typeOf) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// Return Javascript types of parameters, example: (string, object?, ...number[])
+func (overload overloadFunc) signature() string {
+	if overload.fnType == nil {
+		return "(...any)"
+	}
+
+	params := overload.fnType.NumIn() - goFuncSkip(overload.fnType)
+	names := make([]string, params)
+	for index := range names {
+		var typ reflect.Type
+		if overload.fnType.IsVariadic() && index == params-1 {
+			typ = overload.fnType.In(overload.fnType.NumIn() - 1).Elem()
+		} else {
+			typ = overload.param(index)
+		}
+
+		name := "any"
+		if types := jsTypes(typ); types != nil {
+			str := make([]string, len(types))
+			for index, typeOf := range types {
+				str[index] = typeOf.String()
+			}
+			name = strings.Join(str, " | ")
+		}
+		switch {
+		case overload.fnType.IsVariadic() && index == params-1:
+			name = "..." + name + "[]"
+		case isOptionalParam(typ) && strings.Contains(name, " | "):
+			name = "(" + name + ")?"
+		case isOptionalParam(typ):
+			name += "?"
+		}
+		names[index] = name
+	}
+	return "(" + strings.Join(names, ", ") + ")"
+}
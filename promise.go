@@ -28,8 +28,8 @@ func ToPromise(o ValueType) *Promise {
 // The function internally calls napi.CreatePromise to obtain the promise value and deferred handle.
 // If an error occurs during promise creation, it is converted and returned.
 func CreatePromise(env EnvType) (*Promise, error) {
-	promiseValue, promiseDeferred, err := napi.CreatePromise(env.NapiValue())
-	if err := err.ToError(); err != nil {
+	promiseValue, promiseDeferred, status := napi.CreatePromise(env.NapiValue())
+	if err := statusError(env.NapiValue(), "napi_create_promise", status); err != nil {
 		return nil, err
 	}
 	return ToPromise(N_APIValue(env, promiseValue, promiseDeferred)), nil
@@ -39,12 +39,12 @@ func CreatePromise(env EnvType) (*Promise, error) {
 // It calls napi.RejectDeferred to reject the underlying N-API deferred promise
 // using the given ValueType. Returns an error if the rejection fails.
 func (promise *Promise) Reject(value ValueType) error {
-	return napi.RejectDeferred(promise.NapiEnv(), promise.promiseDeferred, value.NapiValue()).ToError()
+	return statusError(promise.NapiEnv(), "napi_reject_deferred", napi.RejectDeferred(promise.NapiEnv(), promise.promiseDeferred, value.NapiValue()))
 }
 
 // Resolve fulfills the promise with the provided value.
 // It resolves the underlying N-API deferred object using the given ValueType.
 // Returns an error if the resolution fails.
 func (promise *Promise) Resolve(value ValueType) error {
-	return napi.ResolveDeferred(promise.NapiEnv(), promise.promiseDeferred, value.NapiValue()).ToError()
+	return statusError(promise.NapiEnv(), "napi_resolve_deferred", napi.ResolveDeferred(promise.NapiEnv(), promise.promiseDeferred, value.NapiValue()))
 }
@@ -0,0 +1,109 @@
+package napi
+
+import (
+	"errors"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// Key to require function in env library data
+type requireKey struct{}
+
+// Function to create require from addon path, works in CommonJS, ESM and worker threads.
+//
+// fileName is undefined without Node-API 9, require load builtins with process.getBuiltinModule
+// and other modules with require of main module.
+const createRequireSource = `function createRequire(fileName) {
+	let Module;
+	if (typeof process === "object" && typeof process.getBuiltinModule === "function") Module = process.getBuiltinModule("module");
+	else if (typeof require === "function") Module = require("module");
+	else if (typeof process === "object" && process.mainModule) Module = process.mainModule.constructor;
+	if (fileName !== undefined) {
+		if (!Module || typeof Module.createRequire !== "function") throw new Error("cannot load node:module to create require");
+		return Module.createRequire(fileName);
+	}
+
+	let mainRequire;
+	if (typeof require === "function") mainRequire = require;
+	else if (typeof process === "object" && process.mainModule) mainRequire = process.mainModule.require.bind(process.mainModule);
+	return function require(specifier) {
+		if (typeof process === "object" && typeof process.getBuiltinModule === "function") {
+			const builtin = process.getBuiltinModule(specifier);
+			if (builtin !== undefined) return builtin;
+		}
+		if (mainRequire) return mainRequire(specifier);
+		const err = new Error("Cannot find module '" + specifier + "', addon file name requires Node-API 9");
+		err.code = "MODULE_NOT_FOUND";
+		throw err;
+	};
+}`
+
+// Require loads Node builtins ("fs", "node:events"), packages and modules relative to the addon file,
+// same as calling require(specifier) in a CommonJS module at addon path.
+//
+// The require function is created with module.createRequire from [EnvType.ModuleFileName] and cached per env,
+// without Node-API 9 builtins are loaded with process.getBuiltinModule and others relative to main module.
+// If module throws on load, the exception is cleared and returned as [*Exception].
+func (e *_Env) Require(specifier string) (ValueType, error) {
+	requireFn, err := requireFunction(e)
+	if err != nil {
+		return nil, err
+	}
+
+	specifierValue, err := CreateString(e, specifier)
+	if err != nil {
+		return nil, err
+	}
+
+	exports, err := requireFn.Call(specifierValue)
+	if errors.Is(err, ErrPendingException) {
+		return nil, lastException(e)
+	}
+	return exports, err
+}
+
+// Return require function cached in env, or create from module file name
+func requireFunction(env EnvType) (*Function, error) {
+	data, err := libraryData(env)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref, ok := data.Load(requireKey{}); ok {
+		napiValue, status := napi.GetReferenceValue(env.NapiValue(), ref.(napi.Reference))
+		if err := statusError(env.NapiValue(), "napi_get_reference_value", status); err != nil {
+			return nil, err
+		} else if napiValue != nil {
+			return ToFunction(N_APIValue(env, napiValue)), nil
+		}
+	}
+
+	var fileNameValue ValueType
+	if fileName, err := env.ModuleFileName(); err == nil {
+		if fileNameValue, err = CreateString(env, fileName); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, errors.ErrUnsupported) {
+		return nil, err
+	} else if fileNameValue, err = env.Undefined(); err != nil {
+		return nil, err
+	}
+
+	createRequire, err := CompileFunction(env, createRequireSource)
+	if err != nil {
+		return nil, err
+	}
+	requireValue, err := createRequire.Call(fileNameValue)
+	if errors.Is(err, ErrPendingException) {
+		return nil, lastException(env)
+	} else if err != nil {
+		return nil, err
+	}
+
+	ref, status := napi.CreateReference(env.NapiValue(), requireValue.NapiValue(), 1)
+	if err := statusError(env.NapiValue(), "napi_create_reference", status); err != nil {
+		return nil, err
+	}
+	data.Store(requireKey{}, ref)
+	return ToFunction(requireValue), nil
+}
@@ -0,0 +1,99 @@
+package napi_test
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+func TestModuleFileName(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		fileName, err := env.ModuleFileName()
+		if !napi.Supports(napi.FeatureModuleFileName) {
+			if !errors.Is(err, errors.ErrUnsupported) {
+				t.Errorf("ModuleFileName without Node-API 9 return %q, %v, want errors.ErrUnsupported", fileName, err)
+			}
+			return
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(fileName, "file://") || !strings.HasSuffix(fileName, ".node") {
+			t.Errorf("ModuleFileName = %q, want file URL of addon", fileName)
+		}
+	})
+}
+
+func TestRequire(t *testing.T) {
+	for _, specifier := range []string{"events", "node:events"} {
+		t.Run(specifier, func(t *testing.T) {
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				events, err := env.Require(specifier)
+				if err != nil {
+					t.Fatal(err)
+				}
+				isEmitter, err := napi.CompileFunction(env, "events => typeof events === 'function' && events.EventEmitter === events")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res, err := isEmitter.Call(events); err != nil {
+					t.Fatal(err)
+				} else if got := describe(t, res); got != "true" {
+					t.Errorf("Require(%q) is not EventEmitter", specifier)
+				}
+			})
+		})
+	}
+}
+
+func TestRequireRelative(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		if !napi.Supports(napi.FeatureModuleFileName) {
+			t.Skip("relative modules require Node-API 9")
+		}
+		fileName, err := env.ModuleFileName()
+		if err != nil {
+			t.Fatal(err)
+		}
+		addon, err := url.Parse(fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dir := filepath.Dir(filepath.FromSlash(addon.Path))
+		for name, source := range map[string]string{
+			"sibling.js": `module.exports = { name: "sibling", dir: __dirname };`,
+			"throws.js":  `throw new RangeError("load failed");`,
+		} {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(source), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		sibling, err := env.Require("./sibling.js")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got struct {
+			Name string `napi:"name"`
+			Dir  string `napi:"dir"`
+		}
+		if err := napi.ValueFrom(sibling, &got); err != nil {
+			t.Fatal(err)
+		} else if got.Name != "sibling" || got.Dir != dir {
+			t.Errorf("Require(\"./sibling.js\") = %+v, want module in %s", got, dir)
+		}
+
+		var exception *napi.Exception
+		if _, err := env.Require("./throws.js"); !errors.As(err, &exception) || exception.Name != "RangeError" {
+			t.Errorf("Require of throwing module return %v, want RangeError", err)
+		}
+		if _, err := env.Require("./missing.js"); !errors.As(err, &exception) || !strings.Contains(exception.Message, "Cannot find module") {
+			t.Errorf("Require of missing module return %v, want Cannot find module", err)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package napi
+
+import (
+	"fmt"
+	"slices"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// Max functions kept by [CompileFunction] per env, least recently used function is removed from cache
+const compiledFunctionsLimit = 64
+
+// Key to compiled functions in env library data
+type compiledFunctionsKey struct{}
+
+// Cache of compiled functions by source, only used in Javascript thread
+type compiledFunctions struct {
+	sources []string // Least recently used first
+	refs    map[string]napi.Reference
+}
+
+// CompileFunction evaluates Javascript function source, example "(a, b) => a + b" or "function (map) { return [...map.keys()] }",
+// and return [*Function] to call from Go.
+//
+// The function is cached by source per env and kept alive by a reference,
+// calling again with same source return the same function without compiling again.
+// Cache keep last 64 sources used, others are compiled again.
+func CompileFunction(env EnvType, source string) (*Function, error) {
+	data, err := libraryData(env)
+	if err != nil {
+		return nil, err
+	}
+	value, _ := data.LoadOrStore(compiledFunctionsKey{}, &compiledFunctions{refs: map[string]napi.Reference{}})
+	cache := value.(*compiledFunctions)
+
+	if ref, ok := cache.refs[source]; ok {
+		napiValue, status := napi.GetReferenceValue(env.NapiValue(), ref)
+		if err := statusError(env.NapiValue(), "napi_get_reference_value", status); err != nil {
+			return nil, err
+		} else if napiValue != nil {
+			cache.use(source)
+			return ToFunction(N_APIValue(env, napiValue)), nil
+		}
+	}
+
+	fn, err := env.RunScript("(" + source + "\n)")
+	if err != nil {
+		return nil, err
+	} else if typeOf, err := fn.Type(); err != nil {
+		return nil, err
+	} else if typeOf != TypeFunction {
+		return nil, fmt.Errorf("script return %s, expected function", typeOf)
+	}
+
+	ref, status := napi.CreateReference(env.NapiValue(), fn.NapiValue(), 1)
+	if err := statusError(env.NapiValue(), "napi_create_reference", status); err != nil {
+		return nil, err
+	}
+	if previous, ok := cache.refs[source]; ok { // Function collected
+		napi.DeleteReference(env.NapiValue(), previous)
+	}
+	cache.refs[source] = ref
+	cache.use(source)
+	for len(cache.sources) > compiledFunctionsLimit {
+		napi.DeleteReference(env.NapiValue(), cache.refs[cache.sources[0]])
+		delete(cache.refs, cache.sources[0])
+		cache.sources = cache.sources[1:]
+	}
+	return ToFunction(fn), nil
+}
+
+// Move source to end of least recently used list
+func (cache *compiledFunctions) use(source string) {
+	if index := slices.Index(cache.sources, source); index >= 0 {
+		cache.sources = slices.Delete(cache.sources, index, index+1)
+	}
+	cache.sources = append(cache.sources, source)
+}
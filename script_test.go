@@ -0,0 +1,121 @@
+package napi_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+func TestRunScript(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		if got := describe(t, script(t, env, "const list = [1, 2]; list.map(n => n * 2)")); got != "[2,4]" {
+			t.Errorf("RunScript = %s, want [2,4]", got)
+		}
+	})
+}
+
+func TestRunScriptException(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		errName string
+		message string
+	}{
+		{"throw", `throw new TypeError("boom")`, "TypeError", "boom"},
+		{"syntax error", `function (`, "SyntaxError", ""},
+		{"throw string", `throw "text"`, "", "text"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				_, err := env.RunScript(test.source)
+				var exception *napi.Exception
+				if !errors.As(err, &exception) {
+					t.Fatalf("RunScript return %T %v, want *napi.Exception", err, err)
+				}
+				if exception.Name != test.errName {
+					t.Errorf("Name = %q, want %q", exception.Name, test.errName)
+				}
+				if test.message != "" && exception.Message != test.message {
+					t.Errorf("Message = %q, want %q", exception.Message, test.message)
+				}
+				// Exception is cleared from env, next call work
+				if got := describe(t, script(t, env, "1 + 1")); got != "2" {
+					t.Errorf("RunScript after exception = %s, want 2", got)
+				}
+			})
+		})
+	}
+}
+
+func TestCompileFunction(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		fn, err := napi.CompileFunction(env, "(a, b) => a + b")
+		if err != nil {
+			t.Fatal(err)
+		}
+		args := []napi.ValueType{script(t, env, "1"), script(t, env, "2")}
+		if res, err := fn.Call(args...); err != nil {
+			t.Fatal(err)
+		} else if got := describe(t, res); got != "3" {
+			t.Errorf("call = %s, want 3", got)
+		}
+
+		if _, err := napi.CompileFunction(env, "1 + 1"); err == nil {
+			t.Error("CompileFunction of number not return error")
+		}
+		var exception *napi.Exception
+		if _, err := napi.CompileFunction(env, "(a, b) =>"); !errors.As(err, &exception) || exception.Name != "SyntaxError" {
+			t.Errorf("CompileFunction of invalid source return %v, want SyntaxError", err)
+		}
+	})
+}
+
+func TestCompileFunctionCache(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		compile := func(source string) *napi.Function {
+			t.Helper()
+			fn, err := napi.CompileFunction(env, source)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return fn
+		}
+		same := func(a, b *napi.Function) bool {
+			t.Helper()
+			ok, err := napi.StrictEqual(env, a, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return ok
+		}
+		// Fill cache with other sources, describe is cached too
+		fill := func(prefix string, count int) {
+			for index := range count {
+				compile("() => " + strconv.Quote(prefix+strconv.Itoa(index)))
+			}
+		}
+
+		first := compile("() => 'first'")
+		if !same(first, compile("() => 'first'")) {
+			t.Fatal("same source return other function")
+		}
+
+		fill("a", 62)
+		if !same(first, compile("() => 'first'")) {
+			t.Fatal("function removed from cache before limit")
+		}
+		fill("b", 63) // first is the most recently used, stay in cache
+		if !same(first, compile("() => 'first'")) {
+			t.Fatal("recently used function removed from cache")
+		}
+
+		fill("c", 64)
+		if same(first, compile("() => 'first'")) {
+			t.Error("least recently used function not removed from cache")
+		}
+	})
+}
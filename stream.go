@@ -0,0 +1,487 @@
+package napi
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// Default size of chunks read from io.Reader and max bytes buffered by [ReaderFrom]
+const streamChunkSize = 64 * 1024
+
+// Max Read calls returning no data and no error before fail with io.ErrNoProgress, same of bufio
+const maxEmptyReads = 100
+
+// Base of streams, threadsafe function to run Go results in Javascript thread and reference to Javascript stream
+type jsStream struct {
+	tsfn   *ThreadsafeFunction
+	stream napi.Reference
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+func (s *jsStream) init(env EnvType, name string, stream ValueType, refCount int) error {
+	tsfn, err := createThreadsafeFunction(env, nil, nil, func(env EnvType, _ *Function, data any) {
+		data.(func(EnvType))(env)
+	}, name, 0, 1, nil)
+	if err != nil {
+		return err
+	} else if err = tsfn.Unref(env); err != nil { // Ref only while waiting Go
+		tsfn.Release(Release)
+		return err
+	}
+	ref, status := napi.CreateReference(env.NapiValue(), stream.NapiValue(), refCount)
+	if err = statusError(env.NapiValue(), "napi_create_reference", status); err != nil {
+		tsfn.Release(Release)
+		return err
+	}
+	s.tsfn, s.stream = tsfn, ref
+	return nil
+}
+
+// Queue fn in Javascript thread, ignored if stream closed
+func (s *jsStream) post(fn func(env EnvType)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.closed {
+		s.tsfn.Call(fn, NonBlocking)
+	}
+}
+
+// Return Javascript stream, nil if collected or closed, in Javascript thread
+func (s *jsStream) value(env EnvType) *Object {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed { // Calls queued before close
+		return nil
+	}
+	napiValue, status := napi.GetReferenceValue(env.NapiValue(), s.stream)
+	if status != napi.StatusOK || napiValue == nil {
+		return nil
+	}
+	return ToObject(N_APIValue(env, napiValue))
+}
+
+// Release threadsafe function and reference, in Javascript thread
+func (s *jsStream) close(env EnvType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		s.tsfn.Release(Release)
+		napi.DeleteReference(env.NapiValue(), s.stream)
+	}
+}
+
+// Create instance of class of node:stream with options
+func newNodeStream(env EnvType, class string, options map[string]Callback) (*Object, error) {
+	stream, err := env.Require("stream")
+	if err != nil {
+		return nil, err
+	}
+	constructor, err := ToObject(stream).Get(class)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := CreateObject(env)
+	if err != nil {
+		return nil, err
+	}
+	for name, callback := range options {
+		fn, err := CreateFunction(env, name, callback)
+		if err != nil {
+			return nil, err
+		} else if err = opts.Set(name, fn); err != nil {
+			return nil, err
+		}
+	}
+	return newInstance(constructor, opts)
+}
+
+// Call callback of stream with error if not nil
+func callStreamCallback(env EnvType, callback ValueType, err error) error {
+	if err == nil {
+		_, err = ToFunction(callback).Call()
+		return err
+	}
+	jsErr, err := CreateError(env, err.Error())
+	if err != nil {
+		return err
+	}
+	_, err = ToFunction(callback).Call(jsErr)
+	return err
+}
+
+// Close reader or writer if is io.Closer, in goroutine to not block Javascript thread
+func closeStream(v any, once *sync.Once) {
+	if closer, ok := v.(io.Closer); ok {
+		once.Do(func() { go closer.Close() })
+	}
+}
+
+type readableStream struct {
+	jsStream
+	reader    io.Reader
+	closeOnce sync.Once
+}
+
+// NewReadable return Node.js stream.Readable with data of reader, read in goroutine when stream request data,
+// reader is closed on stream destroy if is io.Closer.
+//
+//	file, err := os.Open("file.txt")
+//	if err != nil {
+//		return nil, err
+//	}
+//	return napi.NewReadable(ci.Env, file)
+func NewReadable(env EnvType, reader io.Reader) (*Object, error) {
+	s := &readableStream{reader: reader}
+	stream, err := newNodeStream(env, "Readable", map[string]Callback{
+		"read":    s.read,
+		"destroy": s.destroy,
+	})
+	if err != nil {
+		return nil, err
+	} else if err = s.init(env, "napi-go/readable", stream, 0); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// _read(size), called again by Node.js after push if stream need more data
+func (s *readableStream) read(ci *CallbackInfo) (ValueType, error) {
+	size := streamChunkSize
+	if len(ci.Args) > 0 {
+		if n, err := ToNumber(ci.Args[0]).Int(); err == nil && n > 0 {
+			size = int(n)
+		}
+	}
+
+	// Keep stream and event loop alive while reading
+	if _, status := napi.ReferenceRef(ci.Env.NapiValue(), s.stream); status != napi.StatusOK {
+		return nil, statusError(ci.Env.NapiValue(), "napi_reference_ref", status)
+	} else if err := s.tsfn.Ref(ci.Env); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		buff := make([]byte, size)
+		var n int
+		var err error
+		for reads := 0; n == 0 && err == nil; reads++ {
+			if reads == maxEmptyReads {
+				err = io.ErrNoProgress
+				break
+			}
+			n, err = s.reader.Read(buff)
+		}
+		s.post(func(env EnvType) {
+			stream := s.value(env)
+			if stream == nil {
+				return
+			}
+			s.tsfn.Unref(env)
+			napi.ReferenceUnref(env.NapiValue(), s.stream)
+			if n > 0 {
+				chunk, err := CopyBuffer(env, buff[:n])
+				if err != nil {
+					callMethodArgs(stream, "destroy", env, err)
+					return
+				}
+				callMethodArgs(stream, "push", env, nil, chunk)
+			}
+			if err == io.EOF {
+				null, _ := env.Null()
+				callMethodArgs(stream, "push", env, nil, null)
+			} else if err != nil {
+				callMethodArgs(stream, "destroy", env, err)
+			}
+		})
+	}()
+	return nil, nil
+}
+
+// _destroy(err, callback)
+func (s *readableStream) destroy(ci *CallbackInfo) (ValueType, error) {
+	s.close(ci.Env)
+	closeStream(s.reader, &s.closeOnce)
+	return nil, callDestroyCallback(ci)
+}
+
+type writableStream struct {
+	jsStream
+	writer    io.Writer
+	closeOnce sync.Once
+}
+
+// NewWritable return Node.js stream.Writable writing chunks to writer in goroutine,
+// writer is closed on stream end or destroy if is io.Closer.
+//
+//	gzipWriter := gzip.NewWriter(file)
+//	return napi.NewWritable(ci.Env, gzipWriter)
+func NewWritable(env EnvType, writer io.Writer) (*Object, error) {
+	s := &writableStream{writer: writer}
+	stream, err := newNodeStream(env, "Writable", map[string]Callback{
+		"write":   s.write,
+		"final":   s.final,
+		"destroy": s.destroy,
+	})
+	if err != nil {
+		return nil, err
+	} else if err = s.init(env, "napi-go/writable", stream, 0); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// Run fn in goroutine and call callback with result in Javascript thread
+func (s *writableStream) run(env EnvType, callback ValueType, fn func() error) error {
+	ref, status := napi.CreateReference(env.NapiValue(), callback.NapiValue(), 1)
+	if err := statusError(env.NapiValue(), "napi_create_reference", status); err != nil {
+		return err
+	} else if err = s.tsfn.Ref(env); err != nil {
+		napi.DeleteReference(env.NapiValue(), ref)
+		return err
+	}
+	go func() {
+		err := fn()
+		s.post(func(env EnvType) {
+			defer napi.DeleteReference(env.NapiValue(), ref)
+			if s.value(env) == nil {
+				return // Destroyed
+			}
+			s.tsfn.Unref(env)
+			if callback, status := napi.GetReferenceValue(env.NapiValue(), ref); status == napi.StatusOK {
+				callStreamCallback(env, N_APIValue(env, callback), err)
+			}
+		})
+	}()
+	return nil
+}
+
+// _write(chunk, encoding, callback)
+func (s *writableStream) write(ci *CallbackInfo) (ValueType, error) {
+	if len(ci.Args) < 3 {
+		return nil, errors.New("napi: write require chunk, encoding and callback")
+	}
+	data, err := ToBuffer(ci.Args[0]).Data()
+	if err != nil {
+		return nil, callStreamCallback(ci.Env, ci.Args[2], err)
+	}
+	data = bytes.Clone(data) // Buffer memory is only valid in this call
+	return nil, s.run(ci.Env, ci.Args[2], func() error {
+		_, err := s.writer.Write(data)
+		return err
+	})
+}
+
+// _final(callback)
+func (s *writableStream) final(ci *CallbackInfo) (ValueType, error) {
+	if len(ci.Args) < 1 {
+		return nil, errors.New("napi: final require callback")
+	}
+	closer, ok := s.writer.(io.Closer)
+	if !ok {
+		return nil, callStreamCallback(ci.Env, ci.Args[0], nil)
+	}
+	return nil, s.run(ci.Env, ci.Args[0], func() (err error) {
+		s.closeOnce.Do(func() { err = closer.Close() })
+		return err
+	})
+}
+
+// _destroy(err, callback)
+func (s *writableStream) destroy(ci *CallbackInfo) (ValueType, error) {
+	s.close(ci.Env)
+	closeStream(s.writer, &s.closeOnce)
+	return nil, callDestroyCallback(ci)
+}
+
+// Call callback of _destroy(err, callback) with err
+func callDestroyCallback(ci *CallbackInfo) error {
+	if len(ci.Args) < 2 {
+		return nil
+	}
+	_, err := ToFunction(ci.Args[1]).Call(ci.Args[0])
+	return err
+}
+
+// Call method of stream, with Go error converted to Error if err not nil or args
+func callMethodArgs(obj *Object, method string, env EnvType, err error, args ...ValueType) error {
+	if err != nil {
+		jsErr, jsErrErr := CreateError(env, err.Error())
+		if jsErrErr != nil {
+			return jsErrErr
+		}
+		args = []ValueType{jsErr}
+	}
+	fn, err := obj.Get(method)
+	if err != nil {
+		return err
+	}
+	_, err = ToFunction(fn).CallWithGlobal(obj, args...)
+	return err
+}
+
+type streamReader struct {
+	jsStream
+	cond     *sync.Cond
+	chunks   [][]byte
+	buffered int
+	err      error // io.EOF, stream error or io.ErrClosedPipe
+	paused   bool
+}
+
+// ReaderFrom return io.ReadCloser with data of Node.js stream.Readable, call in Javascript thread.
+// Stream is paused while Go not read buffered data, Close destroy stream.
+//
+//	reader, err := napi.ReaderFrom(ci.Args[0])
+//	if err != nil {
+//		return nil, err
+//	}
+//	go func() {
+//		defer reader.Close()
+//		io.Copy(hash, reader)
+//	}()
+func ReaderFrom(readable ValueType) (io.ReadCloser, error) {
+	env := readable.Env()
+	if typeOf, err := readable.Type(); err != nil {
+		return nil, err
+	} else if typeOf != TypeObject {
+		return nil, errors.New("napi: ReaderFrom require stream.Readable")
+	}
+
+	r := &streamReader{}
+	r.cond = sync.NewCond(&r.mu)
+	stream := ToObject(readable)
+	if err := r.init(env, "napi-go/reader", stream, 1); err != nil {
+		return nil, err
+	}
+
+	for event, listener := range map[string]Callback{
+		"data":  r.onData,
+		"end":   func(ci *CallbackInfo) (ValueType, error) { r.finish(ci.Env, io.EOF); return nil, nil },
+		"close": func(ci *CallbackInfo) (ValueType, error) { r.finish(ci.Env, io.ErrUnexpectedEOF); return nil, nil },
+		"error": func(ci *CallbackInfo) (ValueType, error) {
+			var err error = io.ErrUnexpectedEOF
+			if len(ci.Args) > 0 {
+				err = errors.New(exceptionOf(ci.Args[0]).Error()) // Javascript value is valid only in this call
+			}
+			r.finish(ci.Env, err)
+			return nil, nil
+		},
+	} {
+		fn, err := CreateFunction(env, event, listener)
+		if err != nil {
+			r.close(env)
+			return nil, err
+		} else if err = callMethod(stream, "on", event, fn); err != nil {
+			r.close(env)
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func (r *streamReader) onData(ci *CallbackInfo) (ValueType, error) {
+	if len(ci.Args) == 0 {
+		return nil, nil
+	}
+	var chunk []byte
+	if typeOf, _ := ci.Args[0].Type(); typeOf == TypeString {
+		str, err := ToString(ci.Args[0]).Utf8Value()
+		if err != nil {
+			return nil, err
+		}
+		chunk = []byte(str)
+	} else {
+		data, err := ToBuffer(ci.Args[0]).Data()
+		if err != nil {
+			return nil, err
+		}
+		chunk = bytes.Clone(data)
+	}
+
+	r.mu.Lock()
+	if r.closed || r.err != nil {
+		r.mu.Unlock()
+		return nil, nil
+	}
+	r.chunks = append(r.chunks, chunk)
+	r.buffered += len(chunk)
+	r.cond.Broadcast()
+	pause := r.buffered >= streamChunkSize && !r.paused
+	r.paused = r.paused || pause
+	r.mu.Unlock()
+
+	if pause { // Pause until Go read, keep event loop alive to resume
+		if err := r.tsfn.Ref(ci.Env); err != nil {
+			return nil, err
+		} else if stream := r.value(ci.Env); stream != nil {
+			return nil, callMethodArgs(stream, "pause", ci.Env, nil)
+		}
+	}
+	return nil, nil
+}
+
+// Set error of reader and release stream, in Javascript thread
+func (r *streamReader) finish(env EnvType, err error) {
+	r.mu.Lock()
+	if r.err == nil {
+		r.err = err
+	}
+	r.cond.Broadcast()
+	r.mu.Unlock()
+	r.close(env)
+}
+
+func (r *streamReader) Read(p []byte) (n int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for len(r.chunks) == 0 && r.err == nil {
+		r.cond.Wait()
+	}
+	for len(r.chunks) > 0 && n < len(p) {
+		copied := copy(p[n:], r.chunks[0])
+		n += copied
+		if r.chunks[0] = r.chunks[0][copied:]; len(r.chunks[0]) == 0 {
+			r.chunks = r.chunks[1:]
+		}
+	}
+	r.buffered -= n
+	if n > 0 {
+		if r.paused && r.buffered < streamChunkSize/2 && !r.closed {
+			r.paused = false
+			r.tsfn.Call(func(env EnvType) {
+				if stream := r.value(env); stream != nil {
+					r.tsfn.Unref(env)
+					callMethodArgs(stream, "resume", env, nil)
+				}
+			}, NonBlocking)
+		}
+		return n, nil
+	}
+	return 0, r.err
+}
+
+// Close destroy Javascript stream, Read after Close return io.ErrClosedPipe
+func (r *streamReader) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err == nil {
+		r.err = io.ErrClosedPipe
+	}
+	r.chunks, r.buffered = nil, 0
+	r.cond.Broadcast()
+	if !r.closed {
+		r.tsfn.Call(func(env EnvType) {
+			if stream := r.value(env); stream != nil {
+				callMethodArgs(stream, "destroy", env, nil)
+			}
+		}, NonBlocking)
+	}
+	return nil
+}
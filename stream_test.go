@@ -0,0 +1,331 @@
+package napi_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+// Run Javascript condition until return true or fail test after timeout
+func waitJS(t *testing.T, condition string) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		var ok bool
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+			var err error
+			if ok, err = napi.ToBoolean(script(t, env, condition)).Value(); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if ok {
+			return
+		} else if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting %s", condition)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// Call Javascript function with Go function sending string to channel
+func callDone(t *napitest.T, env napi.EnvType, source string, ch chan<- string, args ...napi.ValueType) {
+	t.Helper()
+	fn, err := napi.CompileFunction(env, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done, err := napi.GoFuncOf(env, func(result string) { ch <- result })
+	if err != nil {
+		t.Fatal(err)
+	} else if _, err = fn.Call(append(args, done)...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Return node:stream module
+func streamModule(t *napitest.T, env napi.EnvType) napi.ValueType {
+	t.Helper()
+	stream, err := env.Require("stream")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stream
+}
+
+// Reader and writer recording Close
+type closer struct {
+	io.Reader
+	io.Writer
+	closed atomic.Bool
+}
+
+func (c *closer) Close() error { c.closed.Store(true); return nil }
+
+// Reader return len(p) bytes always, counting bytes read
+type infiniteReader struct{ read atomic.Int64 }
+
+func (r *infiniteReader) Read(p []byte) (int, error) {
+	r.read.Add(int64(len(p)))
+	return len(p), nil
+}
+
+// Reader return 0, nil always
+type emptyReader struct{}
+
+func (emptyReader) Read([]byte) (int, error) { return 0, nil }
+
+// Collect data of readable and send length and content or error message
+const collectReadable = `(stream, done) => {
+	const chunks = [];
+	stream.on("data", chunk => chunks.push(chunk));
+	stream.on("end", () => done("end " + Buffer.concat(chunks).toString()));
+	stream.on("error", err => done("error " + err.message));
+}`
+
+func TestNewReadable(t *testing.T) {
+	large := strings.Repeat("napi-go ", 20*1024) // Bigger than chunk size
+	tests := []struct {
+		name   string
+		reader io.Reader
+		want   string
+	}{
+		{"EOF", strings.NewReader("hello"), "end hello"},
+		{"large", strings.NewReader(large), "end " + large},
+		{"one byte reader", iotest.OneByteReader(strings.NewReader("slow")), "end slow"},
+		{"data with EOF", iotest.DataErrReader(strings.NewReader("data")), "end data"},
+		{"error", io.MultiReader(strings.NewReader("partial"), iotest.ErrReader(errors.New("read failed"))), "error read failed"},
+		{"no progress", emptyReader{}, "error " + io.ErrNoProgress.Error()},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := make(chan string, 1)
+			reader := &closer{Reader: test.reader}
+			napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+				stream, err := napi.NewReadable(env, reader)
+				if err != nil {
+					t.Fatal(err)
+				}
+				callDone(t, env, collectReadable, results, stream)
+			})
+			if got := wait(t, results); got != test.want {
+				t.Errorf("readable %.50q, want %.50q", got, test.want)
+			}
+			if strings.HasPrefix(test.want, "error") {
+				waitClosed(t, &reader.closed)
+			}
+		})
+	}
+}
+
+// Wait close of reader or writer, closed in goroutine
+func waitClosed(t *testing.T, closed *atomic.Bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for !closed.Load() {
+		if time.Now().After(deadline) {
+			t.Fatal("reader or writer not closed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestNewReadableBackpressure(t *testing.T) {
+	reader := &infiniteReader{}
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		stream, err := napi.NewReadable(env, reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		global, err := env.Global()
+		if err != nil {
+			t.Fatal(err)
+		} else if err = global.Set("backpressure", stream); err != nil {
+			t.Fatal(err)
+		}
+		script(t, env, `backpressure.read(0)`) // Start read without consume
+	})
+
+	// Stream without consumer stop read at highWaterMark
+	waitJS(t, `backpressure.readableLength >= backpressure.readableHighWaterMark`)
+	read := reader.read.Load()
+	time.Sleep(100 * time.Millisecond)
+	if reader.read.Load() != read {
+		t.Errorf("reader called while stream buffer is full, read %d bytes and after %d", read, reader.read.Load())
+	}
+
+	// Consume buffer read more
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		script(t, env, `backpressure.read()`)
+	})
+	waitJS(t, `backpressure.readableLength >= backpressure.readableHighWaterMark`)
+	if reader.read.Load() == read {
+		t.Error("reader not called after stream buffer consumed")
+	}
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		script(t, env, `backpressure.destroy()`)
+	})
+}
+
+// Writer failing on write
+type failWriter struct{}
+
+func (failWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestNewWritable(t *testing.T) {
+	t.Run("final", func(t *testing.T) {
+		results := make(chan string, 1)
+		var buff bytes.Buffer
+		writer := &closer{Writer: &buff}
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+			stream, err := napi.NewWritable(env, writer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			callDone(t, env, `(stream, done) => {
+	stream.on("finish", () => done("finish"));
+	stream.on("error", err => done("error " + err.message));
+	stream.write("hello ");
+	stream.write(Buffer.from("napi "));
+	stream.end("go");
+}`, results, stream)
+		})
+		if got := wait(t, results); got != "finish" {
+			t.Fatalf("writable %s", got)
+		}
+		if !writer.closed.Load() {
+			t.Error("writer not closed by end of stream")
+		}
+		if got := buff.String(); got != "hello napi go" {
+			t.Errorf("written %q, want %q", got, "hello napi go")
+		}
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		results := make(chan string, 1)
+		writer := &closer{Writer: failWriter{}}
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+			stream, err := napi.NewWritable(env, writer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			callDone(t, env, `(stream, done) => {
+	stream.on("error", err => done("error " + err.message));
+	stream.write("data", err => err || done("write callback without error"));
+}`, results, stream)
+		})
+		if got := wait(t, results); got != "error write failed" {
+			t.Errorf("writable %s, want error write failed", got)
+		}
+		waitClosed(t, &writer.closed) // Destroyed by error
+	})
+}
+
+func TestReaderFrom(t *testing.T) {
+	t.Run("pause and resume", func(t *testing.T) {
+		var data bytes.Buffer
+		for n := range 4 * 64 * 1024 / 8 {
+			data.WriteString(string(rune('a'+n%26)) + "0123456")
+		}
+		var reader io.ReadCloser
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+			chunk, err := napi.CopyBuffer(env, data.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			stream := script(t, env, `({ Readable }, data) => {
+	const chunks = [];
+	for (let i = 0; i < data.length; i += 16 * 1024) chunks.push(data.subarray(i, i + 16 * 1024));
+	return globalThis.pauseStream = Readable.from(chunks, { objectMode: false });
+}`)
+			if stream, err = napi.ToFunction(stream).Call(streamModule(t, env), chunk); err != nil {
+				t.Fatal(err)
+			}
+			if reader, err = napi.ReaderFrom(stream); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		// Paused with 64KiB buffered until Go read
+		waitJS(t, `pauseStream.isPaused()`)
+		got, err := io.ReadAll(reader)
+		if err != nil {
+			t.Fatal(err)
+		} else if !bytes.Equal(got, data.Bytes()) {
+			t.Errorf("read %d bytes, want %d bytes", len(got), data.Len())
+		}
+		if err := reader.Close(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("close", func(t *testing.T) {
+		var reader io.ReadCloser
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+			stream, err := napi.ToFunction(script(t, env, `({ Readable }) => {
+	const stream = globalThis.closeStream = new Readable({ read() {} });
+	stream.push("data");
+	return stream;
+}`)).Call(streamModule(t, env))
+			if err != nil {
+				t.Fatal(err)
+			} else if reader, err = napi.ReaderFrom(stream); err != nil {
+				t.Fatal(err)
+			}
+		})
+		buff := make([]byte, 4)
+		if _, err := io.ReadFull(reader, buff); err != nil {
+			t.Fatal(err)
+		} else if string(buff) != "data" {
+			t.Errorf("read %q, want %q", buff, "data")
+		}
+
+		// Read waiting data return after Close
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := reader.Read(buff); !errors.Is(err, io.ErrClosedPipe) {
+				t.Errorf("Read while Close = %v, want io.ErrClosedPipe", err)
+			}
+		}()
+		time.Sleep(10 * time.Millisecond)
+		if err := reader.Close(); err != nil {
+			t.Fatal(err)
+		}
+		wg.Wait()
+		waitJS(t, `closeStream.destroyed`)
+		if _, err := reader.Read(buff); !errors.Is(err, io.ErrClosedPipe) {
+			t.Errorf("Read after Close = %v, want io.ErrClosedPipe", err)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var reader io.ReadCloser
+		napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+			stream, err := napi.ToFunction(script(t, env, `({ Readable }) => {
+	const stream = new Readable({ read() {} });
+	stream.push("data");
+	setImmediate(() => stream.destroy(new Error("stream failed")));
+	return stream;
+}`)).Call(streamModule(t, env))
+			if err != nil {
+				t.Fatal(err)
+			} else if reader, err = napi.ReaderFrom(stream); err != nil {
+				t.Fatal(err)
+			}
+		})
+		defer reader.Close()
+		if got, err := io.ReadAll(reader); err == nil || err.Error() != "Error: stream failed" {
+			t.Errorf("ReadAll = %q, %v, want error Error: stream failed", got, err)
+		}
+	})
+}
@@ -13,8 +13,8 @@ func ToString(o ValueType) *String { return &String{o} }
 
 // Create [*String] from go string
 func CreateString(env EnvType, str string) (*String, error) {
-	napiString, err := mustValueErr(napi.CreateStringUtf8(env.NapiValue(), str))
-	if err != nil {
+	napiString, status := napi.CreateStringUtf8(env.NapiValue(), str)
+	if err := statusError(env.NapiValue(), "napi_create_string_utf8", status); err != nil {
 		return nil, err
 	}
 	return ToString(N_APIValue(env, napiString)), nil
@@ -22,22 +22,34 @@ func CreateString(env EnvType, str string) (*String, error) {
 
 // Create string to utf16
 func CreateStringUtf16(env EnvType, str []rune) (*String, error) {
-	napiString, err := mustValueErr(napi.CreateStringUtf16(env.NapiValue(), utf16.Encode(str)))
-	if err != nil {
+	napiString, status := napi.CreateStringUtf16(env.NapiValue(), utf16.Encode(str))
+	if err := statusError(env.NapiValue(), "napi_create_string_utf16", status); err != nil {
 		return nil, err
 	}
 	return ToString(N_APIValue(env, napiString)), nil
 }
 
+// CoerceString converts any [ValueType] to [*String], same as String(value) in Javascript.
+//
+// Symbols cannot be converted, napi_coerce_to_string throw TypeError in Javascript.
+func CoerceString(value ValueType) (*String, error) {
+	napiValue, status := napi.CoerceToString(value.NapiEnv(), value.NapiValue())
+	if err := statusError(value.NapiEnv(), "napi_coerce_to_string", status); err != nil {
+		return nil, err
+	}
+	return ToString(N_APIValue(value.Env(), napiValue)), nil
+}
+
 // Get String value.
 func (str *String) Utf8Value() (string, error) {
-	return mustValueErr(napi.GetValueStringUtf8(str.NapiEnv(), str.NapiValue()))
+	value, status := napi.GetValueStringUtf8(str.NapiEnv(), str.NapiValue())
+	return value, statusError(str.NapiEnv(), "napi_get_value_string_utf8", status)
 }
 
 // Converts a String value to a UTF-16 encoded in rune.
 func (str *String) Utf16Value() ([]rune, error) {
-	valueOf, err := mustValueErr(napi.GetValueStringUtf16(str.NapiEnv(), str.NapiValue()))
-	if err != nil {
+	valueOf, status := napi.GetValueStringUtf16(str.NapiEnv(), str.NapiValue())
+	if err := statusError(str.NapiEnv(), "napi_get_value_string_utf16", status); err != nil {
 		return nil, err
 	}
 	return utf16.Decode(valueOf), nil
@@ -4,28 +4,17 @@ import (
 	"fmt"
 	"reflect"
 	"runtime"
-	"runtime/cgo"
 	"sync"
-	"unsafe"
 
 	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
 )
 
-/*
-#include <node/node_api.h>
-
-// Forward declaration of the C callback functions
-extern void executeThreadsafeFunctionCallJSCallback(napi_env env, napi_value js_callback, void* context, void* data);
-extern void finalizeThreadsafeFunctionCallback(napi_env env, void* finalize_data, void* finalize_hint);
-*/
-import "C"
-
 // ThreadsafeFunction represents a N-API thread-safe function.
 type ThreadsafeFunction struct {
 	value
-	tsfn             napi.ThreadsafeFunction
-	callJSCallback   ThreadsafeFunctionCallJSCallback // Callback to execute on the main thread
-	goCallbackHandle cgo.Handle                       // Handle to the Go callback data
+	tsfn           napi.ThreadsafeFunction
+	callJSCallback ThreadsafeFunctionCallJSCallback // Callback to execute on the main thread
+	context        any                              // Context provided during creation
 }
 
 // ThreadsafeFunctionReleaseMode is an alias for napi.ThreadsafeFunctionReleaseMode,
@@ -66,70 +55,19 @@ var (
 	tsfnCallbacksMutex sync.RWMutex
 )
 
-type threadsafeCallbackData struct {
-	tsfn   *ThreadsafeFunction
-	goData any // Data received from the background thread
-}
-
-//export executeThreadsafeFunctionCallJSCallback
-func executeThreadsafeFunctionCallJSCallback(cEnv C.napi_env, cJsCallback C.napi_value, _ unsafe.Pointer, data unsafe.Pointer) {
-	// This function runs on the main Node.js thread
-	env := N_APIEnv(napi.Env(cEnv))
-
-	// Retrieve the ThreadsafeFunction instance and the data from Go handles
-	callbackDataHandle := cgo.Handle(data)
-	callbackData := callbackDataHandle.Value().(*threadsafeCallbackData)
-	tsfn := callbackData.tsfn
-	goData := callbackData.goData
-	callbackDataHandle.Delete() // Clean up the handle for the data
-
+// Called in main Node.js thread with data received from background thread
+func (tsfn *ThreadsafeFunction) callJS(env napi.Env, jsCallback napi.Value, data any) {
 	// It's crucial to handle potential panics in the callback
 	defer func() {
 		if r := recover(); r != nil {
 			errStr := fmt.Sprintf("panic recovered in threadsafe function callback: %v", r)
-			napi.ThrowError(env.NapiValue(), "", errStr) // Optionally throw an error back to the main loop
+			napi.ThrowError(env, "", errStr) // Optionally throw an error back to the main loop
 		}
 	}()
 
-	if tsfn == nil || tsfn.callJSCallback == nil {
-		napi.ThrowError(env.NapiValue(), "", "Error: ThreadsafeFunction or its callback is nil in executeThreadsafeFunctionCallJSCallback")
-		return
-	}
-
-	// Wrap the JS callback N-API value
-	jsCallbackFunc := ToFunction(N_APIValue(env, napi.Value(cJsCallback)))
-
-	// Execute the user-provided Go callback
-	tsfn.callJSCallback(env, jsCallbackFunc, goData)
-}
-
-//export finalizeThreadsafeFunctionCallback
-func finalizeThreadsafeFunctionCallback(cEnv C.napi_env, finalizeData unsafe.Pointer, _ unsafe.Pointer) {
-	// This function is called when the threadsafe function is being destroyed
-	// Retrieve the Go handle for the callback data
-	handle := cgo.Handle(finalizeData)
-	callbackData := handle.Value().(*struct {
-		Context          any
-		FinalizeCallback ThreadsafeFunctionFinalizeCallback
-		TsfnWrapper      *ThreadsafeFunction
-	})
-
-	// Call the user-provided finalize callback if present
-	if callbackData.FinalizeCallback != nil {
-		env := N_APIEnv(napi.Env(cEnv))
-		// It's crucial to handle potential panics in the callback
-		defer func() {
-			if r := recover(); r != nil {
-				errStr := fmt.Sprintf("panic recovered in threadsafe function finalize callback: %v", r)
-				napi.ThrowError(env.NapiValue(), "", errStr)
-			}
-		}()
-
-		callbackData.FinalizeCallback(env, callbackData.Context)
-	}
-
-	// Clean up the Go handle
-	handle.Delete()
+	// Wrap the JS callback N-API value and execute the user-provided Go callback
+	envType := N_APIEnv(env)
+	tsfn.callJSCallback(envType, ToFunction(N_APIValue(envType, jsCallback)), data)
 }
 
 // CreateThreadsafeFunction creates a new N-API thread-safe function.
@@ -150,11 +88,6 @@ func CreateThreadsafeFunction(env EnvType, jsFunc Callback, finalizeCallback Thr
 		return nil, fmt.Errorf("callJSCallback cannot be nil")
 	}
 
-	resourceNameVal, err := CreateString(env, resourceName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create resource name string: %w", err)
-	}
-
 	var jsFuncVal napi.Value
 	if jsFunc != nil {
 		jsFn, err := CreateFunction(env, runtime.FuncForPC(reflect.ValueOf(jsFunc).Pointer()).Name(), jsFunc)
@@ -163,54 +96,55 @@ func CreateThreadsafeFunction(env EnvType, jsFunc Callback, finalizeCallback Thr
 		}
 		jsFuncVal = jsFn.NapiValue()
 	}
+	return createThreadsafeFunction(env, jsFuncVal, finalizeCallback, callJSCallback, resourceName, maxQueueSize, initialThreadCount, context)
+}
 
-	tsfnWrapper := &ThreadsafeFunction{
-		callJSCallback: callJSCallback,
+// Create threadsafe function with Javascript function value, jsFuncVal can be nil
+func createThreadsafeFunction(env EnvType, jsFuncVal napi.Value, finalizeCallback ThreadsafeFunctionFinalizeCallback, callJSCallback ThreadsafeFunctionCallJSCallback, resourceName string, maxQueueSize, initialThreadCount int, context any) (*ThreadsafeFunction, error) {
+	resourceNameVal, err := CreateString(env, resourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource name string: %w", err)
 	}
 
-	// Store context and finalize callback if provided
-	callbackData := &struct {
-		Context          any
-		FinalizeCallback ThreadsafeFunctionFinalizeCallback
-		TsfnWrapper      *ThreadsafeFunction // Reference back to the wrapper
-	}{
-		Context:          context,
-		FinalizeCallback: finalizeCallback,
-		TsfnWrapper:      tsfnWrapper,
+	tsfnWrapper := &ThreadsafeFunction{
+		callJSCallback: callJSCallback,
+		context:        context,
 	}
-	tsfnWrapper.goCallbackHandle = cgo.NewHandle(callbackData)
 
-	var finalizeCbPtr C.napi_finalize
+	var finalize napi.ThreadsafeFunctionFinalize
 	if finalizeCallback != nil {
-		finalizeCbPtr = C.napi_finalize(C.finalizeThreadsafeFunctionCallback)
+		finalize = func(env napi.Env) {
+			// It's crucial to handle potential panics in the callback
+			defer func() {
+				if r := recover(); r != nil {
+					errStr := fmt.Sprintf("panic recovered in threadsafe function finalize callback: %v", r)
+					napi.ThrowError(env, "", errStr)
+				}
+			}()
+			finalizeCallback(N_APIEnv(env), context)
+		}
 	}
 
-	var cTsfn napi.ThreadsafeFunction
-	status := napi.Status(C.napi_create_threadsafe_function(
-		C.napi_env(env.NapiValue()),
-		C.napi_value(jsFuncVal),
+	napiTsfn, status := napi.CreateThreadsafeFunction(
+		env.NapiValue(),
+		jsFuncVal,
 		nil, // async_resource (optional)
-		C.napi_value(resourceNameVal.NapiValue()),
-		C.size_t(maxQueueSize),
-		C.size_t(initialThreadCount),
-		unsafe.Pointer(tsfnWrapper.goCallbackHandle), // thread_finalize_data
-		finalizeCbPtr,                                // thread_finalize_cb (optional)
-		unsafe.Pointer(tsfnWrapper.goCallbackHandle), // context
-		C.napi_threadsafe_function_call_js(C.executeThreadsafeFunctionCallJSCallback), // call_js_cb
-		(*C.napi_threadsafe_function)(unsafe.Pointer(&cTsfn)),
-	))
-
-	if err := status.ToError(); err != nil {
-		tsfnWrapper.goCallbackHandle.Delete() // Clean up handle on failure
+		resourceNameVal.NapiValue(),
+		maxQueueSize,
+		initialThreadCount,
+		finalize,
+		tsfnWrapper.callJS,
+	)
+	if err := statusError(env.NapiValue(), "napi_create_threadsafe_function", status); err != nil {
 		return nil, fmt.Errorf("failed to create threadsafe function: %w", err)
 	}
 
-	tsfnWrapper.tsfn = cTsfn
+	tsfnWrapper.tsfn = napiTsfn
 	tsfnWrapper.value = N_APIValue(env, jsFuncVal) // Use jsFunc as the underlying value if provided
 
 	// Store the mapping from N-API handle to Go wrapper
 	tsfnCallbacksMutex.Lock()
-	tsfnCallbacks[cTsfn] = tsfnWrapper
+	tsfnCallbacks[napiTsfn] = tsfnWrapper
 	tsfnCallbacksMutex.Unlock()
 
 	return tsfnWrapper, nil
@@ -218,16 +152,7 @@ func CreateThreadsafeFunction(env EnvType, jsFunc Callback, finalizeCallback Thr
 
 // GetContext retrieves the context data provided during creation.
 func (tsfn *ThreadsafeFunction) GetContext() (any, error) {
-	if tsfn.goCallbackHandle == 0 {
-		return nil, fmt.Errorf("threadsafe function has no associated context handle (possibly already finalized)")
-	}
-	// We stored the handle in the wrapper itself during creation
-	callbackData := tsfn.goCallbackHandle.Value().(*struct {
-		Context          any
-		FinalizeCallback ThreadsafeFunctionFinalizeCallback
-		TsfnWrapper      *ThreadsafeFunction
-	})
-	return callbackData.Context, nil
+	return tsfn.context, nil
 }
 
 // Call sends data from a background thread to the main Node.js thread.
@@ -238,21 +163,8 @@ func (tsfn *ThreadsafeFunction) Call(data any, mode ThreadsafeFunctionCallMode)
 		return fmt.Errorf("threadsafe function is not initialized or already released")
 	}
 
-	// Create a handle for the Go data to pass it safely through C
-	// The handle will be deleted by the executeThreadsafeFunctionCallJSCallback on the main thread.
-	dataHandle := cgo.NewHandle(&threadsafeCallbackData{
-		tsfn:   tsfn,
-		goData: data,
-	})
-
-	status := napi.CallThreadsafeFunction(
-		tsfn.tsfn,
-		// unsafe.Pointer(dataHandle), // Pass the handle as data
-		mode,
-	)
-	if err := status.ToError(); err != nil {
-		// If the call fails, we need to delete the handle ourselves
-		dataHandle.Delete()
+	status := napi.CallThreadsafeFunction(tsfn.tsfn, data, mode)
+	if err := statusError(nil, "napi_call_threadsafe_function", status); err != nil {
 		// Specific error handling for queue full might be needed
 		if status == napi.StatusQueueFull && mode == NonBlocking {
 			return fmt.Errorf("threadsafe function queue is full: %w", err)
@@ -269,7 +181,7 @@ func (tsfn *ThreadsafeFunction) Acquire() error {
 	if tsfn.tsfn == nil {
 		return fmt.Errorf("threadsafe function is not initialized or already released")
 	}
-	return singleMustValueErr(napi.AcquireThreadsafeFunction(tsfn.tsfn))
+	return statusError(nil, "napi_acquire_threadsafe_function", napi.AcquireThreadsafeFunction(tsfn.tsfn))
 }
 
 // Release decrements the reference count for the thread-safe function.
@@ -292,7 +204,7 @@ func (tsfn *ThreadsafeFunction) Release(mode napi.ThreadsafeFunctionReleaseMode)
 	delete(tsfnCallbacks, tsfn.tsfn)
 	tsfnCallbacksMutex.Unlock()
 
-	err := singleMustValueErr(napi.ReleaseThreadsafeFunction(tsfn.tsfn, mode))
+	err := statusError(nil, "napi_release_threadsafe_function", napi.ReleaseThreadsafeFunction(tsfn.tsfn, mode))
 	if err == nil {
 		// If release was successful, mark the Go wrapper as invalid
 		// tsfn.tsfn = nil // Be careful with concurrent access if doing this
@@ -315,7 +227,7 @@ func (tsfn *ThreadsafeFunction) Ref(env EnvType) error {
 	}
 	// Ensure this is called from the main thread (N-API doesn't enforce this, but it's best practice)
 	// Checking the thread ID might be complex. Rely on user discipline for now.
-	return singleMustValueErr(napi.RefThreadsafeFunction(env.NapiValue(), tsfn.tsfn))
+	return statusError(env.NapiValue(), "napi_ref_threadsafe_function", napi.RefThreadsafeFunction(env.NapiValue(), tsfn.tsfn))
 }
 
 // Unref decrements the N-API reference count.
@@ -325,5 +237,5 @@ func (tsfn *ThreadsafeFunction) Unref(env EnvType) error {
 		return fmt.Errorf("threadsafe function is not initialized or already released")
 	}
 	// Ensure this is called from the main thread
-	return singleMustValueErr(napi.UnrefThreadsafeFunction(env.NapiValue(), tsfn.tsfn))
+	return statusError(env.NapiValue(), "napi_unref_threadsafe_function", napi.UnrefThreadsafeFunction(env.NapiValue(), tsfn.tsfn))
 }
@@ -0,0 +1,86 @@
+package napi_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+func TestThreadsafeFunction(t *testing.T) {
+	received := make(chan int, 10)
+	finalized := make(chan any, 1)
+	var tsfn *napi.ThreadsafeFunction
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		var err error
+		tsfn, err = napi.CreateThreadsafeFunction(env, nil, func(env napi.EnvType, context any) {
+			finalized <- context
+		}, func(env napi.EnvType, _ *napi.Function, data any) {
+			if _, err := env.Global(); err != nil { // Node-API calls work in callback
+				t.Error(err)
+			}
+			received <- data.(int)
+		}, "napitest/tsfn", 0, 1, "context")
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var wg sync.WaitGroup
+	for n := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := tsfn.Call(n, napi.Blocking); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var got []int
+	for range 10 {
+		got = append(got, wait(t, received))
+	}
+	slices.Sort(got)
+	if want := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !slices.Equal(got, want) {
+		t.Errorf("received %v, want %v", got, want)
+	}
+
+	if err := tsfn.Release(napi.Release); err != nil {
+		t.Fatal(err)
+	}
+	if context := wait(t, finalized); context != "context" {
+		t.Errorf("finalize context = %v, want %q", context, "context")
+	}
+}
+
+func TestNewTSFN(t *testing.T) {
+	type point struct{ X, Y int }
+	received := make(chan point, 3)
+	var tsfn *napi.TSFN[point]
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		fn, err := napi.GoFuncOf(env, func(p point) { received <- p })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tsfn, err = napi.NewTSFN[point](env, fn, napi.TSFNOptions{Mode: napi.Blocking}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	defer tsfn.Release()
+
+	for n := range 3 {
+		if err := tsfn.Call(context.Background(), point{n, n * 2}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for n := range 3 {
+		if got, want := wait(t, received), (point{n, n * 2}); got != want {
+			t.Errorf("call %d: got %v, want %v", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package napi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// TSFNOptions configure threadsafe function created by [NewTSFN]
+type TSFNOptions struct {
+	Name      string                     // Async resource name, default is "napi-go/tsfn"
+	QueueSize int                        // Max calls in queue, 0 is unlimited
+	Mode      ThreadsafeFunctionCallMode // NonBlocking (default) return ErrQueueFull if queue is full, Blocking wait space in queue
+	Errors    chan<- error               // Receive exceptions of Javascript function, if nil exception is uncaught exception in Node.js
+	Context   context.Context            // Release threadsafe function when context is done
+}
+
+// TSFN is threadsafe function with Javascript function, values passed to [TSFN.Call]
+// are converted with [ValueOf] in Javascript thread and passed as argument to function.
+type TSFN[T any] struct {
+	tsfn   *ThreadsafeFunction
+	mode   ThreadsafeFunctionCallMode
+	errors chan<- error
+
+	mu     sync.RWMutex
+	closed bool
+	done   chan struct{}
+}
+
+// NewTSFN create threadsafe function to call jsFunc from any goroutine with T value.
+//
+//	tsfn, err := napi.NewTSFN[Progress](env, ci.Args[0], napi.TSFNOptions{Mode: napi.Blocking, Context: ctx})
+//	if err != nil {
+//		return nil, err
+//	}
+//	go func() {
+//		defer tsfn.Release()
+//		for progress := range work {
+//			if err := tsfn.Call(ctx, progress); err != nil {
+//				return
+//			}
+//		}
+//	}()
+func NewTSFN[T any](env EnvType, jsFunc ValueType, opts TSFNOptions) (*TSFN[T], error) {
+	if typeOf, err := jsFunc.Type(); err != nil {
+		return nil, err
+	} else if typeOf != TypeFunction {
+		return nil, fmt.Errorf("napi: NewTSFN require function, got %s", typeOf)
+	}
+	if opts.Name == "" {
+		opts.Name = "napi-go/tsfn"
+	}
+
+	fn := &TSFN[T]{mode: opts.Mode, errors: opts.Errors, done: make(chan struct{})}
+	tsfn, err := createThreadsafeFunction(env, jsFunc.NapiValue(), nil, func(env EnvType, jsCallback *Function, data any) {
+		value, _ := data.(T)
+		fn.callJS(env, jsCallback, value)
+	}, opts.Name, opts.QueueSize, 1, nil)
+	if err != nil {
+		return nil, err
+	}
+	fn.tsfn = tsfn
+
+	if opts.Context != nil {
+		go func() {
+			select {
+			case <-opts.Context.Done():
+				fn.Release()
+			case <-fn.done:
+			}
+		}()
+	}
+	return fn, nil
+}
+
+// Convert value and call Javascript function, in Javascript thread
+func (fn *TSFN[T]) callJS(env EnvType, jsCallback *Function, value T) {
+	jsValue, err := ValueOf(env, value)
+	if err == nil {
+		_, err = jsCallback.Call(jsValue)
+	}
+	switch {
+	case err == nil:
+		return
+	case fn.errors == nil: // Keep exception pending to Node.js report as uncaught exception
+		if !errors.Is(err, ErrPendingException) {
+			ThrowError(env, "", err.Error())
+		}
+		return
+	case errors.Is(err, ErrPendingException):
+		err = lastException(env)
+	}
+
+	// Not block Javascript thread if channel is full
+	select {
+	case fn.errors <- err:
+	default:
+	}
+}
+
+// Call queue value to Javascript function, safe to call from any goroutine.
+//
+// In Blocking mode Call wait space in queue until ctx is done, after [TSFN.Release] Call return [ErrClosing].
+func (fn *TSFN[T]) Call(ctx context.Context, value T) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// Acquire to keep threadsafe function alive while Release is called in other goroutine
+	fn.mu.RLock()
+	if fn.closed {
+		fn.mu.RUnlock()
+		return statusError(nil, "napi_call_threadsafe_function", napi.StatusClosing)
+	}
+	status := napi.AcquireThreadsafeFunction(fn.tsfn.tsfn)
+	fn.mu.RUnlock()
+	if err := statusError(nil, "napi_acquire_threadsafe_function", status); err != nil {
+		return err
+	}
+	defer napi.ReleaseThreadsafeFunction(fn.tsfn.tsfn, Release)
+
+	if fn.mode == NonBlocking || ctx.Done() == nil {
+		return fn.tsfn.Call(value, fn.mode)
+	}
+
+	// Blocking call cannot be cancelled, retry non blocking call until queue has space or ctx is done
+	for delay := time.Microsecond; ; delay = min(delay*2, 10*time.Millisecond) {
+		if err := fn.tsfn.Call(value, NonBlocking); !errors.Is(err, ErrQueueFull) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// Release threadsafe function, calls already in queue are called before finalize.
+// Release can be called multiple times and from any goroutine.
+func (fn *TSFN[T]) Release() error {
+	fn.mu.Lock()
+	defer fn.mu.Unlock()
+	if fn.closed {
+		return nil
+	}
+	fn.closed = true
+	close(fn.done)
+	return fn.tsfn.Release(Release)
+}
+
+// Ref threadsafe function to keep Node.js event loop alive while it not released (default), call in Javascript thread.
+func (fn *TSFN[T]) Ref(env EnvType) error { return fn.tsfn.Ref(env) }
+
+// Unref threadsafe function to not block Node.js exit, call in Javascript thread.
+func (fn *TSFN[T]) Unref(env EnvType) error { return fn.tsfn.Unref(env) }
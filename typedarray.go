@@ -50,7 +50,7 @@ func CreateTypedArray(env EnvType, Type TypedArrayType, length, byteOffset int,
 		arrayValue.NapiValue(), byteOffset,
 	)
 
-	if err = status.ToError(); err != nil {
+	if err = statusError(env.NapiValue(), "napi_create_typedarray", status); err != nil {
 		return nil, err
 	}
 
@@ -74,7 +74,7 @@ func (typed TypedArray) Type() TypedArrayType { return TypedArrayType(typed.type
 func (typed TypedArray) Get() (data []byte, arr *ArrayBuffer, err error) {
 	// TypedArrayType, int, *byte, Value, int, Status
 	_, _, dataPoint, value, byteOffset, status := napi.GetTypedArrayInfo(typed.NapiEnv(), typed.NapiValue())
-	if err = status.ToError(); err != nil {
+	if err = statusError(typed.NapiEnv(), "napi_get_typedarray_info", status); err != nil {
 		return
 	}
 	data = unsafe.Slice(dataPoint, byteOffset)
@@ -86,7 +86,7 @@ func (typed TypedArray) Get() (data []byte, arr *ArrayBuffer, err error) {
 func ToTypedArray(value ValueType) *TypedArray {
 	// TypedArrayType, int, *byte, Value, int, Status
 	typeN, _, _, _, _, status := napi.GetTypedArrayInfo(value.NapiEnv(), value.NapiValue())
-	if err := status.ToError(); err != nil {
+	if err := statusError(value.NapiEnv(), "napi_get_typedarray_info", status); err != nil {
 		panic(err)
 	}
 	return &TypedArray{value: value, typeArray: typeN}
@@ -0,0 +1,87 @@
+package napi
+
+import (
+	"errors"
+	"fmt"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+)
+
+// Node-API version of headers used to build the addon, set with napi8, napi9 or napi10 build tags,
+// without tags build with NAPI_VERSION_EXPERIMENTAL (2147483647).
+const BuildVersion uint32 = napi.BuildVersion
+
+// Feature is a Node-API group of functions introduced in a specific Node-API version
+type Feature int
+
+const (
+	FeatureThreadsafeFunction Feature = iota // napi_create_threadsafe_function and friends, Node-API 4
+	FeatureDate                              // napi_create_date, Node-API 5
+	FeatureBigInt                            // napi_create_bigint_*, Node-API 6
+	FeatureInstanceData                      // napi_set_instance_data, Node-API 6
+	FeatureDetachArrayBuffer                 // napi_detach_arraybuffer, Node-API 7
+	FeatureObjectFreeze                      // napi_object_freeze and napi_object_seal, Node-API 8
+	FeatureModuleFileName                    // node_api_get_module_file_name, Node-API 9
+	FeatureSymbolFor                         // node_api_symbol_for, Node-API 9
+	FeatureSyntaxError                       // node_api_create_syntax_error, Node-API 9
+	FeaturePropertyKey                       // node_api_create_property_key_*, Node-API 10
+)
+
+var featureInfo = [...]struct {
+	name    string
+	version uint32
+}{
+	FeatureThreadsafeFunction: {"ThreadsafeFunction", 4},
+	FeatureDate:               {"Date", 5},
+	FeatureBigInt:             {"BigInt", 6},
+	FeatureInstanceData:       {"InstanceData", 6},
+	FeatureDetachArrayBuffer:  {"DetachArrayBuffer", 7},
+	FeatureObjectFreeze:       {"ObjectFreeze", 8},
+	FeatureModuleFileName:     {"ModuleFileName", 9},
+	FeatureSymbolFor:          {"SymbolFor", 9},
+	FeatureSyntaxError:        {"SyntaxError", 9},
+	FeaturePropertyKey:        {"PropertyKey", 10},
+}
+
+// Node-API version required to feature
+func (feature Feature) Version() uint32 {
+	if feature < 0 || int(feature) >= len(featureInfo) {
+		return 0
+	}
+	return featureInfo[feature].version
+}
+
+func (feature Feature) String() string {
+	if feature < 0 || int(feature) >= len(featureInfo) {
+		return fmt.Sprintf("Feature(%d)", int(feature))
+	}
+	return featureInfo[feature].name
+}
+
+// Supports report if feature is compiled in addon and available in Node.js runtime loaded addon.
+//
+// Runtime version is only know after module init, before return false to features compiled in.
+func Supports(feature Feature) bool {
+	version := feature.Version()
+	if version == 0 || version > BuildVersion {
+		return false
+	}
+	return version <= napi.RuntimeVersion()
+}
+
+// Return Node-API version supported by Node.js runtime
+func (e *_Env) NapiVersion() (uint32, error) {
+	version, status := napi.GetVersion(e.NapiEnv)
+	if err := statusError(e.NapiEnv, "napi_get_version", status); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// Return error wrapping [errors.ErrUnsupported] if feature not supported
+func unsupported(feature Feature) error {
+	if Supports(feature) {
+		return nil
+	}
+	return fmt.Errorf("napi: %s requires Node-API %d: %w", feature, feature.Version(), errors.ErrUnsupported)
+}
@@ -0,0 +1,98 @@
+package napi_test
+
+import (
+	"errors"
+	"testing"
+
+	"sirherobrine23.com.br/Sirherobrine23/napi-go"
+	internalNapi "sirherobrine23.com.br/Sirherobrine23/napi-go/internal/napi"
+	"sirherobrine23.com.br/Sirherobrine23/napi-go/napitest"
+)
+
+// Run with each build tag, example:
+//
+//	NAPITEST_BUILDFLAGS=-tags=napi8 go test -tags napi8 -run Version .
+//
+// napi10 tag require Node.js with Node-API 10 to load addon.
+func TestNapiVersion(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		version, err := env.NapiVersion()
+		if err != nil {
+			t.Fatal(err)
+		} else if version < 8 {
+			t.Fatalf("NapiVersion = %d, want at least 8", version)
+		} else if runtime := internalNapi.RuntimeVersion(); version != runtime {
+			t.Errorf("NapiVersion = %d, runtime version stored on init %d", version, runtime)
+		}
+
+		for feature := napi.FeatureThreadsafeFunction; feature <= napi.FeaturePropertyKey; feature++ {
+			want := feature.Version() <= napi.BuildVersion && feature.Version() <= version
+			if got := napi.Supports(feature); got != want {
+				t.Errorf("Supports(%s) = %v, want %v (build %d, runtime %d)", feature, got, want, napi.BuildVersion, version)
+			}
+		}
+		if napi.Supports(napi.Feature(-1)) || napi.Supports(napi.FeaturePropertyKey+1) {
+			t.Error("Supports of unknown feature return true")
+		}
+	})
+}
+
+func TestVersionGated(t *testing.T) {
+	napitest.Run(t, func(t *napitest.T, env napi.EnvType) {
+		if _, err := env.ModuleFileName(); napi.Supports(napi.FeatureModuleFileName) {
+			if err != nil {
+				t.Errorf("ModuleFileName: %s", err)
+			}
+		} else if !errors.Is(err, errors.ErrUnsupported) {
+			t.Errorf("ModuleFileName without %s return %v, want errors.ErrUnsupported", napi.FeatureModuleFileName, err)
+		}
+
+		// Functions compiled out by build tags return napi_generic_failure
+		gated := []struct {
+			feature napi.Feature
+			call    func() internalNapi.Status
+		}{
+			{napi.FeatureSymbolFor, func() internalNapi.Status {
+				_, status := internalNapi.SymbolFor(env.NapiValue(), "napi-go")
+				return status
+			}},
+			{napi.FeatureSyntaxError, func() internalNapi.Status {
+				code, _ := napi.CreateString(env, "ERR_TEST")
+				msg, _ := napi.CreateString(env, "test")
+				_, status := internalNapi.CreateSyntaxError(env.NapiValue(), code.NapiValue(), msg.NapiValue())
+				return status
+			}},
+			{napi.FeaturePropertyKey, func() internalNapi.Status {
+				_, status := internalNapi.CreatePropertyKeyUtf8(env.NapiValue(), "napi-go")
+				return status
+			}},
+		}
+		for _, test := range gated {
+			var want internalNapi.Status
+			switch {
+			case test.feature.Version() > napi.BuildVersion:
+				want = internalNapi.StatusGenericFailure
+			case napi.Supports(test.feature):
+				want = internalNapi.StatusOK
+			default:
+				continue // Compiled in, experimental in runtime
+			}
+			if got := test.call(); got != want {
+				t.Errorf("%s: status %s, want %s", test.feature, got, want)
+			}
+		}
+	})
+}
+
+func TestFeature(t *testing.T) {
+	if got := napi.FeatureModuleFileName.String(); got != "ModuleFileName" {
+		t.Errorf("String() = %q, want ModuleFileName", got)
+	} else if got := napi.FeatureModuleFileName.Version(); got != 9 {
+		t.Errorf("Version() = %d, want 9", got)
+	}
+	if got := napi.Feature(-1).String(); got != "Feature(-1)" {
+		t.Errorf("String() = %q, want Feature(-1)", got)
+	} else if got := napi.Feature(-1).Version(); got != 0 {
+		t.Errorf("Version() = %d, want 0", got)
+	}
+}